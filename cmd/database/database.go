@@ -5,8 +5,10 @@ import (
 	"log"
 	"os"
 
+	"github.com/glebarez/sqlite"
 	"github.com/joho/godotenv"
 	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
 
@@ -15,24 +17,61 @@ var DB *gorm.DB
 func ConnectDB() {
 	_ = godotenv.Load()
 
+	driver := os.Getenv("DB_DRIVER")
+	if driver == "" {
+		driver = "mysql"
+	}
+
+	dialector, err := OpenDialector(driver)
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+
+	// TranslateError supaya duplicate key / foreign key dari semua driver jadi error gorm yang sama
+	DB, err = gorm.Open(dialector, &gorm.Config{TranslateError: true})
+	if err != nil {
+		log.Fatalf("failed to connect db %v", err)
+	}
+
+	fmt.Printf("✅ Connected to %s database successfully\n", driver)
+}
+
+// OpenDialector builds the gorm dialector for DB_DRIVER (mysql, postgres or
+// sqlite). All driver-specific configuration lives here, utils/testdb uses
+// it too so the tests run against the same settings.
+func OpenDialector(driver string) (gorm.Dialector, error) {
 	dbHost := os.Getenv("DB_HOST")
 	dbPort := os.Getenv("DB_PORT")
 	dbUser := os.Getenv("DB_USER")
 	dbPassword := os.Getenv("DB_PASSWORD")
 	dbName := os.Getenv("DB_NAME")
 
-	if dbHost == "" || dbPort == "" || dbUser == "" || dbPassword == "" || dbName == "" {
-		log.Fatal("❌ Database configuration is missing")
+	switch driver {
+	case "sqlite":
+		// DB_NAME adalah path file database, cukup untuk demo dan CI
+		if dbName == "" {
+			dbName = "chat.db"
+		}
+		dsn := fmt.Sprintf("file:%s?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)", dbName)
+		return sqlite.Open(dsn), nil
+	case "mysql", "postgres":
+	default:
+		return nil, fmt.Errorf("unsupported DB_DRIVER %q (mysql, postgres, sqlite)", driver)
 	}
 
-	dsn := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?charset=utf8mb4&parseTime=True&loc=Local", dbUser, dbPassword, dbHost, dbPort, dbName)
-
-	var err error
+	if dbHost == "" || dbPort == "" || dbUser == "" || dbPassword == "" || dbName == "" {
+		return nil, fmt.Errorf("database configuration is missing")
+	}
 
-	DB, err = gorm.Open(mysql.Open(dsn), &gorm.Config{})
-	if err != nil {
-		log.Fatalf("failed to connect db %v", err)
+	if driver == "postgres" {
+		sslMode := os.Getenv("DB_SSLMODE")
+		if sslMode == "" {
+			sslMode = "disable"
+		}
+		dsn := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=%s", dbHost, dbPort, dbUser, dbPassword, dbName, sslMode)
+		return postgres.Open(dsn), nil
 	}
 
-	fmt.Println("✅ Connected to database successfully")
+	dsn := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?charset=utf8mb4&parseTime=True&loc=Local", dbUser, dbPassword, dbHost, dbPort, dbName)
+	return mysql.Open(dsn), nil
 }
@@ -3,12 +3,17 @@ package main
 import (
 	"chat_api/cmd/database"
 	"chat_api/pb"
+	"chat_api/service/gateway"
 	"chat_api/service/handler"
 	"chat_api/service/repository"
 	"chat_api/service/usecase"
 	"chat_api/utils/interceptor"
+	"chat_api/utils/search"
+	"chat_api/utils/storage"
+	"context"
 	"log"
 	"net"
+	"net/http"
 
 	"google.golang.org/grpc"
 )
@@ -25,9 +30,10 @@ func main() {
 	streamInterceptor := interceptor.StreamInterceptor()
 	unaryInterceptor := interceptor.UnaryInterceptor()
 
+	// interceptor error paling luar supaya semua error (termasuk auth) dipetakan ke status code
 	opts := []grpc.ServerOption{
-		grpc.StreamInterceptor(streamInterceptor),
-		grpc.UnaryInterceptor(unaryInterceptor),
+		grpc.ChainStreamInterceptor(interceptor.ErrorStreamInterceptor(), streamInterceptor, interceptor.ValidationStreamInterceptor()),
+		grpc.ChainUnaryInterceptor(interceptor.ErrorUnaryInterceptor(), unaryInterceptor, interceptor.ValidationUnaryInterceptor()),
 	}
 
 	grpcServer := grpc.NewServer(opts...)
@@ -37,11 +43,41 @@ func main() {
 	authHandler := handler.NewAuthHandler(authUsecase)
 	pb.RegisterAuthServer(grpcServer, authHandler)
 
+	// blob storage untuk attachment
+	store, err := storage.New()
+	if err != nil {
+		log.Fatalf("failed to init storage: %v", err)
+	}
+
+	// index pencarian pesan
+	searchIndex, err := search.New()
+	if err != nil {
+		log.Fatalf("failed to init search index: %v", err)
+	}
+
 	chatRepo := repository.NewChatRepo(database.DB)
-	chatUsecase := usecase.NewChatUsecase(chatRepo)
+	chatUsecase := usecase.NewChatUsecase(chatRepo, store, searchIndex)
 	chatHandler := handler.NewChatHandler(chatUsecase)
 	pb.RegisterChatServiceServer(grpcServer, chatHandler)
 
+	// profil user, perubahan dikabarkan lewat stream chat
+	userRepo := repository.NewUserRepo(database.DB)
+	userUsecase := usecase.NewUserUsecase(userRepo, chatUsecase)
+	userHandler := handler.NewUserHandler(userUsecase)
+	pb.RegisterUserServiceServer(grpcServer, userHandler)
+
+	// REST/JSON gateway + WebSocket/SSE bridge
+	gatewayHandler, err := gateway.NewHandler(context.Background(), "localhost:50051", chatHandler)
+	if err != nil {
+		log.Fatalf("failed to create gateway: %v", err)
+	}
+	go func() {
+		log.Println("HTTP gateway running at :8080")
+		if err := http.ListenAndServe(":8080", gatewayHandler); err != nil {
+			log.Fatalf("failed to serve gateway: %v", err)
+		}
+	}()
+
 	log.Println("gRPC server running at :50051")
 	if err := grpcServer.Serve(lis); err != nil {
 		log.Fatalf("failed to serve: %v", err)
@@ -2,11 +2,47 @@ package main
 
 import (
 	"chat_api/cmd/database"
-	"chat_api/entity"
+	"chat_api/cmd/migrations/migrate"
+	"chat_api/cmd/migrations/versions"
+	"fmt"
 	"log"
+	"os"
+	"strconv"
+	"time"
 )
 
+const migrationsDir = "cmd/migrations/versions"
+
+func usage() {
+	fmt.Fprintln(os.Stderr, `usage: migrations <command>
+
+commands:
+  up             apply all pending migrations
+  down [n]       roll back the last n migrations (default 1)
+  status         show applied and pending migrations
+  redo           roll back and re-apply the last migration
+  create <name>  create an empty migration in `+migrationsDir)
+	os.Exit(2)
+}
+
 func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+	cmd := os.Args[1]
+
+	// create tidak butuh koneksi database
+	if cmd == "create" {
+		if len(os.Args) < 3 {
+			usage()
+		}
+		path, err := migrate.Create(migrationsDir, os.Args[2])
+		if err != nil {
+			log.Fatalf("gagal membuat migrasi %v", err)
+		}
+		log.Println("migrasi dibuat:", path)
+		return
+	}
 
 	database.ConnectDB()
 
@@ -14,11 +50,43 @@ func main() {
 		log.Fatal("❌ Database belum diinisialisasi")
 	}
 
-	err := database.DB.AutoMigrate(&entity.User{}, entity.ChatGroup{}, entity.GroupMember{}, entity.Chat{}, entity.ChatRead{})
-	if err != nil {
-		log.Fatalf("gagal migrasi boy %v", err)
-	}
+	m := migrate.New(database.DB, versions.All())
 
-	log.Println("berhasil migrasi")
+	switch cmd {
+	case "up":
+		if err := m.Up(); err != nil {
+			log.Fatalf("gagal migrasi boy %v", err)
+		}
+	case "down":
+		steps := 1
+		if len(os.Args) > 2 {
+			n, err := strconv.Atoi(os.Args[2])
+			if err != nil || n < 1 {
+				usage()
+			}
+			steps = n
+		}
+		if err := m.Down(steps); err != nil {
+			log.Fatalf("gagal rollback %v", err)
+		}
+	case "redo":
+		if err := m.Redo(); err != nil {
+			log.Fatalf("gagal redo %v", err)
+		}
+	case "status":
+	default:
+		usage()
+	}
 
+	statuses, err := m.Status()
+	if err != nil {
+		log.Fatalf("gagal membaca status migrasi %v", err)
+	}
+	for _, st := range statuses {
+		appliedAt := "-"
+		if st.AppliedAt != nil {
+			appliedAt = st.AppliedAt.Format(time.RFC3339)
+		}
+		fmt.Printf("%04d  %-9s %-25s %s\n", st.Version, st.State, appliedAt, st.Name)
+	}
 }
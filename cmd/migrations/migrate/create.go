@@ -0,0 +1,72 @@
+package migrate
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var fileName = regexp.MustCompile(`^(\d{4})_([a-z0-9_]+)\.go$`)
+
+const template = `package versions
+
+import "gorm.io/gorm"
+
+func init() {
+	register(
+		func(tx *gorm.DB) error {
+			return nil
+		},
+		func(tx *gorm.DB) error {
+			return nil
+		},
+	)
+}
+`
+
+// ParseFileName splits "0002_add_chat_indexes.go" into its version and name.
+func ParseFileName(base string) (uint, string, error) {
+	match := fileName.FindStringSubmatch(base)
+	if match == nil {
+		return 0, "", fmt.Errorf("invalid migration file name %q (want NNNN_name.go)", base)
+	}
+	version, err := strconv.ParseUint(match[1], 10, 32)
+	if err != nil {
+		return 0, "", err
+	}
+	return uint(version), match[2], nil
+}
+
+// Create writes an empty migration with the next version number into dir and
+// returns its path.
+func Create(dir, name string) (string, error) {
+	name = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(name), " ", "_"))
+	if !regexp.MustCompile(`^[a-z0-9_]+$`).MatchString(name) {
+		return "", fmt.Errorf("invalid migration name %q", name)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return "", err
+	}
+
+	var next uint = 1
+	for _, entry := range entries {
+		version, _, err := ParseFileName(entry.Name())
+		if err != nil {
+			continue
+		}
+		if version >= next {
+			next = version + 1
+		}
+	}
+
+	path := filepath.Join(dir, fmt.Sprintf("%04d_%s.go", next, name))
+	if err := os.WriteFile(path, []byte(template), 0o644); err != nil {
+		return "", err
+	}
+	return path, nil
+}
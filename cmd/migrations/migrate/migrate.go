@@ -0,0 +1,215 @@
+package migrate
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Migration is one versioned schema change. Checksum is taken from the
+// migration source so edits after it has been applied can be detected.
+type Migration struct {
+	Version  uint
+	Name     string
+	Checksum string
+	Up       func(tx *gorm.DB) error
+	Down     func(tx *gorm.DB) error
+}
+
+// SchemaMigration is a row of the schema_migrations table.
+type SchemaMigration struct {
+	Version   uint   `gorm:"primaryKey;autoIncrement:false"`
+	Name      string `gorm:"not null"`
+	Checksum  string `gorm:"not null"`
+	AppliedAt time.Time
+}
+
+type Status struct {
+	Version   uint
+	Name      string
+	State     string
+	AppliedAt *time.Time
+}
+
+const (
+	StatePending  = "pending"
+	StateApplied  = "applied"
+	StateModified = "modified"
+	StateMissing  = "missing"
+)
+
+type Migrator struct {
+	db         *gorm.DB
+	migrations []Migration
+}
+
+func New(db *gorm.DB, migrations []Migration) *Migrator {
+	sorted := append([]Migration(nil), migrations...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Version < sorted[j].Version })
+	return &Migrator{db: db, migrations: sorted}
+}
+
+// Up applies every pending migration in version order.
+func (m *Migrator) Up() error {
+	applied, err := m.verify()
+	if err != nil {
+		return err
+	}
+
+	for _, mg := range m.migrations {
+		if _, ok := applied[mg.Version]; ok {
+			continue
+		}
+		if err := m.apply(mg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Down rolls back the last steps applied migrations.
+func (m *Migrator) Down(steps int) error {
+	applied, err := m.verify()
+	if err != nil {
+		return err
+	}
+
+	for i := len(m.migrations) - 1; i >= 0 && steps > 0; i-- {
+		mg := m.migrations[i]
+		if _, ok := applied[mg.Version]; !ok {
+			continue
+		}
+		if err := m.rollback(mg); err != nil {
+			return err
+		}
+		steps--
+	}
+	return nil
+}
+
+// Redo rolls back and re-applies the last applied migration.
+func (m *Migrator) Redo() error {
+	applied, err := m.verify()
+	if err != nil {
+		return err
+	}
+
+	for i := len(m.migrations) - 1; i >= 0; i-- {
+		mg := m.migrations[i]
+		if _, ok := applied[mg.Version]; !ok {
+			continue
+		}
+		if err := m.rollback(mg); err != nil {
+			return err
+		}
+		return m.apply(mg)
+	}
+	return fmt.Errorf("no applied migration to redo")
+}
+
+func (m *Migrator) Status() ([]Status, error) {
+	applied, err := m.applied()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []Status
+	known := make(map[uint]bool)
+	for _, mg := range m.migrations {
+		known[mg.Version] = true
+		st := Status{Version: mg.Version, Name: mg.Name, State: StatePending}
+		if row, ok := applied[mg.Version]; ok {
+			st.State = StateApplied
+			st.AppliedAt = &row.AppliedAt
+			if row.Checksum != mg.Checksum {
+				st.State = StateModified
+			}
+		}
+		result = append(result, st)
+	}
+
+	for version, row := range applied {
+		if known[version] {
+			continue
+		}
+		result = append(result, Status{Version: version, Name: row.Name, State: StateMissing, AppliedAt: &row.AppliedAt})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Version < result[j].Version })
+
+	return result, nil
+}
+
+func (m *Migrator) apply(mg Migration) error {
+	err := m.db.Transaction(func(tx *gorm.DB) error {
+		if err := mg.Up(tx); err != nil {
+			return err
+		}
+		return tx.Create(&SchemaMigration{
+			Version:   mg.Version,
+			Name:      mg.Name,
+			Checksum:  mg.Checksum,
+			AppliedAt: time.Now(),
+		}).Error
+	})
+	if err != nil {
+		return fmt.Errorf("migration %04d_%s up: %w", mg.Version, mg.Name, err)
+	}
+	return nil
+}
+
+func (m *Migrator) rollback(mg Migration) error {
+	err := m.db.Transaction(func(tx *gorm.DB) error {
+		if err := mg.Down(tx); err != nil {
+			return err
+		}
+		return tx.Where("version = ?", mg.Version).Delete(&SchemaMigration{}).Error
+	})
+	if err != nil {
+		return fmt.Errorf("migration %04d_%s down: %w", mg.Version, mg.Name, err)
+	}
+	return nil
+}
+
+// verify refuses to run when an applied migration was edited or removed from
+// the code, since the database no longer matches what the code describes.
+func (m *Migrator) verify() (map[uint]SchemaMigration, error) {
+	applied, err := m.applied()
+	if err != nil {
+		return nil, err
+	}
+
+	known := make(map[uint]Migration)
+	for _, mg := range m.migrations {
+		known[mg.Version] = mg
+	}
+
+	for version, row := range applied {
+		mg, ok := known[version]
+		if !ok {
+			return nil, fmt.Errorf("migration %04d_%s is applied but missing from the code", version, row.Name)
+		}
+		if mg.Checksum != row.Checksum {
+			return nil, fmt.Errorf("migration %04d_%s was edited after it was applied (checksum mismatch)", version, mg.Name)
+		}
+	}
+	return applied, nil
+}
+
+func (m *Migrator) applied() (map[uint]SchemaMigration, error) {
+	if err := m.db.AutoMigrate(&SchemaMigration{}); err != nil {
+		return nil, err
+	}
+
+	var rows []SchemaMigration
+	if err := m.db.Order("version ASC").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	applied := make(map[uint]SchemaMigration, len(rows))
+	for _, row := range rows {
+		applied[row.Version] = row
+	}
+	return applied, nil
+}
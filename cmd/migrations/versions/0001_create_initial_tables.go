@@ -0,0 +1,82 @@
+package versions
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// snapshot of the entities at the time of this migration. Existing databases
+// created with the old AutoMigrate tool are adopted as-is.
+type user0001 struct {
+	ID         uint      `gorm:"primaryKey"`
+	Username   string    `gorm:"unique"`
+	Email      string    `gorm:"unique"`
+	Password   string    `gorm:"not null"`
+	IsVerified bool      `gorm:"default:false"`
+	CreatedAt  time.Time `gorm:"autoCreateTime"`
+}
+
+func (user0001) TableName() string { return "users" }
+
+type chatGroup0001 struct {
+	ID          uint   `gorm:"primaryKey"`
+	Name        string `gorm:"not null"`
+	Description string
+	LastMessage string
+	UnreadCount int
+	Members     []groupMember0001 `gorm:"foreignKey:GroupID;constraint:OnDelete:CASCADE"`
+	Chats       []chat0001        `gorm:"foreignKey:GroupID;constraint:OnDelete:CASCADE"`
+}
+
+func (chatGroup0001) TableName() string { return "chat_groups" }
+
+type groupMember0001 struct {
+	ID        uint          `gorm:"primaryKey"`
+	UserID    uint          `gorm:"index"`
+	User      user0001      `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+	GroupID   uint          `gorm:"index"`
+	Role      string        `gorm:"not null"`
+	ChatGroup chatGroup0001 `gorm:"foreignKey:GroupID;constraint:OnDelete:CASCADE"`
+}
+
+func (groupMember0001) TableName() string { return "group_members" }
+
+type chat0001 struct {
+	ID            uint             `gorm:"primaryKey"`
+	GroupMemberID *uint            `gorm:"index"`
+	GroupMember   *groupMember0001 `gorm:"foreignKey:GroupMemberID;constraint:OnDelete:SET NULL"`
+	Message       string           `gorm:"not null"`
+	GroupID       uint             `gorm:"index"`
+	CreatedAt     time.Time        `gorm:"autoCreateTime"`
+	ReadStatus    []chatRead0001   `gorm:"foreignKey:ChatID;constraint:OnDelete:CASCADE"`
+}
+
+func (chat0001) TableName() string { return "chats" }
+
+type chatRead0001 struct {
+	ID            uint            `gorm:"primaryKey"`
+	GroupMemberID uint            `gorm:"uniqueIndex:idx_chat"`
+	GroupMember   groupMember0001 `gorm:"foreignKey:GroupMemberID;OnDelete:CASCADE"`
+	ChatID        uint            `gorm:"uniqueIndex:idx_chat"`
+	IsRead        bool            `gorm:"default:false"`
+}
+
+func (chatRead0001) TableName() string { return "chat_reads" }
+
+func init() {
+	register(
+		func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&user0001{}, &chatGroup0001{}, &groupMember0001{}, &chat0001{}, &chatRead0001{})
+		},
+		func(tx *gorm.DB) error {
+			// satu per satu, urutan dari tabel anak supaya foreign key tidak melanggar
+			for _, table := range []string{"chat_reads", "chats", "group_members", "chat_groups", "users"} {
+				if err := tx.Migrator().DropTable(table); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	)
+}
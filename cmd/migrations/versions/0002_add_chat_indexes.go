@@ -0,0 +1,31 @@
+package versions
+
+import "gorm.io/gorm"
+
+// indexes used by history replay, unread counting and membership lookups
+var indexes0002 = []struct{ table, name, columns string }{
+	{"chats", "idx_chats_group_created", "group_id, created_at"},
+	{"chat_reads", "idx_chat_reads_member_read", "group_member_id, is_read"},
+	{"group_members", "idx_group_members_user_group", "user_id, group_id"},
+}
+
+func init() {
+	register(
+		func(tx *gorm.DB) error {
+			for _, idx := range indexes0002 {
+				if err := tx.Exec("CREATE INDEX " + idx.name + " ON " + idx.table + " (" + idx.columns + ")").Error; err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		func(tx *gorm.DB) error {
+			for _, idx := range indexes0002 {
+				if err := tx.Migrator().DropIndex(idx.table, idx.name); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	)
+}
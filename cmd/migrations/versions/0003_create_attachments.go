@@ -0,0 +1,36 @@
+package versions
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type attachment0003 struct {
+	ID            uint             `gorm:"primaryKey"`
+	GroupID       uint             `gorm:"index"`
+	ChatGroup     chatGroup0001    `gorm:"foreignKey:GroupID;constraint:OnDelete:CASCADE"`
+	GroupMemberID *uint            `gorm:"index"`
+	GroupMember   *groupMember0001 `gorm:"foreignKey:GroupMemberID;constraint:OnDelete:SET NULL"`
+	ChatID        *uint            `gorm:"index"`
+	Chat          *chat0001        `gorm:"foreignKey:ChatID;constraint:OnDelete:CASCADE"`
+	FileName      string           `gorm:"not null"`
+	ContentType   string           `gorm:"not null"`
+	Size          int64            `gorm:"not null"`
+	Checksum      string           `gorm:"size:64;not null"`
+	StorageKey    string           `gorm:"size:255;not null;unique"`
+	CreatedAt     time.Time        `gorm:"autoCreateTime"`
+}
+
+func (attachment0003) TableName() string { return "attachments" }
+
+func init() {
+	register(
+		func(tx *gorm.DB) error {
+			return tx.Migrator().CreateTable(&attachment0003{})
+		},
+		func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable("attachments")
+		},
+	)
+}
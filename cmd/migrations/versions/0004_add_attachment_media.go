@@ -0,0 +1,64 @@
+package versions
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type attachment0004 struct {
+	ID            uint      `gorm:"primaryKey"`
+	GroupID       uint      `gorm:"index"`
+	GroupMemberID *uint     `gorm:"index"`
+	ChatID        *uint     `gorm:"index"`
+	FileName      string    `gorm:"not null"`
+	ContentType   string    `gorm:"not null"`
+	Size          int64     `gorm:"not null"`
+	Checksum      string    `gorm:"size:64;not null"`
+	StorageKey    string    `gorm:"size:255;not null;unique"`
+	Width         int       `gorm:"not null;default:0"`
+	Height        int       `gorm:"not null;default:0"`
+	Blurhash      string    `gorm:"size:64"`
+	Status        string    `gorm:"size:16;not null;default:ready"`
+	CreatedAt     time.Time `gorm:"autoCreateTime"`
+}
+
+func (attachment0004) TableName() string { return "attachments" }
+
+type attachmentThumbnail0004 struct {
+	ID           uint            `gorm:"primaryKey"`
+	AttachmentID uint            `gorm:"uniqueIndex:idx_attachment_thumbnail_size"`
+	Attachment   *attachment0003 `gorm:"foreignKey:AttachmentID;constraint:OnDelete:CASCADE"`
+	Size         int             `gorm:"uniqueIndex:idx_attachment_thumbnail_size"`
+	Width        int             `gorm:"not null"`
+	Height       int             `gorm:"not null"`
+	StorageKey   string          `gorm:"size:255;not null;unique"`
+}
+
+func (attachmentThumbnail0004) TableName() string { return "attachment_thumbnails" }
+
+var columns0004 = []string{"Width", "Height", "Blurhash", "Status"}
+
+func init() {
+	register(
+		func(tx *gorm.DB) error {
+			for _, column := range columns0004 {
+				if err := tx.Migrator().AddColumn(&attachment0004{}, column); err != nil {
+					return err
+				}
+			}
+			return tx.Migrator().CreateTable(&attachmentThumbnail0004{})
+		},
+		func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropTable("attachment_thumbnails"); err != nil {
+				return err
+			}
+			for _, column := range columns0004 {
+				if err := tx.Migrator().DropColumn(&attachment0004{}, column); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	)
+}
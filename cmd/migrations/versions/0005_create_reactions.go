@@ -0,0 +1,30 @@
+package versions
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type reaction0005 struct {
+	ID            uint            `gorm:"primaryKey"`
+	ChatID        uint            `gorm:"uniqueIndex:idx_reaction_member_emoji"`
+	Chat          chat0001        `gorm:"foreignKey:ChatID;constraint:OnDelete:CASCADE"`
+	GroupMemberID uint            `gorm:"uniqueIndex:idx_reaction_member_emoji;index"`
+	GroupMember   groupMember0001 `gorm:"foreignKey:GroupMemberID;constraint:OnDelete:CASCADE"`
+	Emoji         string          `gorm:"size:32;not null;uniqueIndex:idx_reaction_member_emoji"`
+	CreatedAt     time.Time       `gorm:"autoCreateTime"`
+}
+
+func (reaction0005) TableName() string { return "reactions" }
+
+func init() {
+	register(
+		func(tx *gorm.DB) error {
+			return tx.Migrator().CreateTable(&reaction0005{})
+		},
+		func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable("reactions")
+		},
+	)
+}
@@ -0,0 +1,59 @@
+package versions
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// reply_to_id and thread_root_id have no foreign key: SQLite can only add one
+// by rebuilding chats, and dropping chats there cascades into every child
+// table. The repository clears both columns when a chat is deleted.
+type chat0006 struct {
+	ReplyToID        *uint
+	ThreadRootID     *uint
+	ThreadReplyCount int `gorm:"not null;default:0"`
+	LastReplyAt      *time.Time
+}
+
+func (chat0006) TableName() string { return "chats" }
+
+var (
+	columns0006 = []string{"ReplyToID", "ThreadRootID", "ThreadReplyCount", "LastReplyAt"}
+	indexes0006 = []struct{ name, column string }{
+		{"idx_chats_reply_to", "reply_to_id"},
+		{"idx_chats_thread_root", "thread_root_id"},
+	}
+)
+
+func init() {
+	register(
+		func(tx *gorm.DB) error {
+			for _, column := range columns0006 {
+				if err := tx.Migrator().AddColumn(&chat0006{}, column); err != nil {
+					return err
+				}
+			}
+			for _, idx := range indexes0006 {
+				if err := tx.Exec("CREATE INDEX " + idx.name + " ON chats (" + idx.column + ")").Error; err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		func(tx *gorm.DB) error {
+			for _, idx := range indexes0006 {
+				if err := tx.Migrator().DropIndex("chats", idx.name); err != nil {
+					return err
+				}
+			}
+			// DROP COLUMN langsung, DropColumn gorm di SQLite membuat ulang tabel chats
+			for _, column := range []string{"reply_to_id", "thread_root_id", "thread_reply_count", "last_reply_at"} {
+				if err := tx.Exec("ALTER TABLE chats DROP COLUMN " + column).Error; err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	)
+}
@@ -0,0 +1,30 @@
+package versions
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type mention0007 struct {
+	ID            uint            `gorm:"primaryKey"`
+	ChatID        uint            `gorm:"uniqueIndex:idx_mention_chat_member"`
+	Chat          chat0001        `gorm:"foreignKey:ChatID;constraint:OnDelete:CASCADE"`
+	GroupMemberID uint            `gorm:"uniqueIndex:idx_mention_chat_member;index:idx_mentions_member_read,priority:1"`
+	GroupMember   groupMember0001 `gorm:"foreignKey:GroupMemberID;constraint:OnDelete:CASCADE"`
+	IsRead        bool            `gorm:"default:false;index:idx_mentions_member_read,priority:2"`
+	CreatedAt     time.Time       `gorm:"autoCreateTime"`
+}
+
+func (mention0007) TableName() string { return "mentions" }
+
+func init() {
+	register(
+		func(tx *gorm.DB) error {
+			return tx.Migrator().CreateTable(&mention0007{})
+		},
+		func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable("mentions")
+		},
+	)
+}
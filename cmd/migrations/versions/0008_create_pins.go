@@ -0,0 +1,31 @@
+package versions
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type pin0008 struct {
+	ID            uint             `gorm:"primaryKey"`
+	ChatID        uint             `gorm:"uniqueIndex"`
+	Chat          chat0001         `gorm:"foreignKey:ChatID;constraint:OnDelete:CASCADE"`
+	GroupID       uint             `gorm:"index"`
+	ChatGroup     chatGroup0001    `gorm:"foreignKey:GroupID;constraint:OnDelete:CASCADE"`
+	GroupMemberID *uint            `gorm:"index"`
+	GroupMember   *groupMember0001 `gorm:"foreignKey:GroupMemberID;constraint:OnDelete:SET NULL"`
+	CreatedAt     time.Time        `gorm:"autoCreateTime"`
+}
+
+func (pin0008) TableName() string { return "pins" }
+
+func init() {
+	register(
+		func(tx *gorm.DB) error {
+			return tx.Migrator().CreateTable(&pin0008{})
+		},
+		func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable("pins")
+		},
+	)
+}
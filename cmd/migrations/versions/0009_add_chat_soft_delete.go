@@ -0,0 +1,38 @@
+package versions
+
+import (
+	"gorm.io/gorm"
+)
+
+// deleted_by_id has no foreign key for the same reason as reply_to_id (0006)
+type chat0009 struct {
+	DeletedAt   gorm.DeletedAt
+	DeletedByID *uint
+}
+
+func (chat0009) TableName() string { return "chats" }
+
+func init() {
+	register(
+		func(tx *gorm.DB) error {
+			for _, column := range []string{"DeletedAt", "DeletedByID"} {
+				if err := tx.Migrator().AddColumn(&chat0009{}, column); err != nil {
+					return err
+				}
+			}
+			return tx.Exec("CREATE INDEX idx_chats_deleted_at ON chats (deleted_at)").Error
+		},
+		func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropIndex("chats", "idx_chats_deleted_at"); err != nil {
+				return err
+			}
+			// DROP COLUMN langsung, lihat 0006
+			for _, column := range []string{"deleted_at", "deleted_by_id"} {
+				if err := tx.Exec("ALTER TABLE chats DROP COLUMN " + column).Error; err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	)
+}
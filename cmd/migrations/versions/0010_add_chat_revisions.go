@@ -0,0 +1,43 @@
+package versions
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type chat0010 struct {
+	EditedAt *time.Time
+}
+
+func (chat0010) TableName() string { return "chats" }
+
+type chatRevision0010 struct {
+	ID            uint             `gorm:"primaryKey"`
+	ChatID        uint             `gorm:"index"`
+	Chat          chat0001         `gorm:"foreignKey:ChatID;constraint:OnDelete:CASCADE"`
+	Message       string           `gorm:"not null"`
+	GroupMemberID *uint            `gorm:"index"`
+	GroupMember   *groupMember0001 `gorm:"foreignKey:GroupMemberID;constraint:OnDelete:SET NULL"`
+	CreatedAt     time.Time        `gorm:"autoCreateTime"`
+}
+
+func (chatRevision0010) TableName() string { return "chat_revisions" }
+
+func init() {
+	register(
+		func(tx *gorm.DB) error {
+			if err := tx.Migrator().AddColumn(&chat0010{}, "EditedAt"); err != nil {
+				return err
+			}
+			return tx.Migrator().CreateTable(&chatRevision0010{})
+		},
+		func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropTable("chat_revisions"); err != nil {
+				return err
+			}
+			// DROP COLUMN langsung, lihat 0006
+			return tx.Exec("ALTER TABLE chats DROP COLUMN edited_at").Error
+		},
+	)
+}
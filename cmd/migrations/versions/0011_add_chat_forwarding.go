@@ -0,0 +1,38 @@
+package versions
+
+import (
+	"gorm.io/gorm"
+)
+
+// no foreign keys, see 0006
+type chat0011 struct {
+	ForwardedFromChatID    *uint
+	ForwardedFromGroupID   *uint
+	ForwardedFromUserID    *uint
+	ForwardedFromUsername  string
+	ForwardedFromGroupName string
+}
+
+func (chat0011) TableName() string { return "chats" }
+
+func init() {
+	register(
+		func(tx *gorm.DB) error {
+			for _, column := range []string{"ForwardedFromChatID", "ForwardedFromGroupID", "ForwardedFromUserID", "ForwardedFromUsername", "ForwardedFromGroupName"} {
+				if err := tx.Migrator().AddColumn(&chat0011{}, column); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		func(tx *gorm.DB) error {
+			// DROP COLUMN langsung, lihat 0006
+			for _, column := range []string{"forwarded_from_chat_id", "forwarded_from_group_id", "forwarded_from_user_id", "forwarded_from_username", "forwarded_from_group_name"} {
+				if err := tx.Exec("ALTER TABLE chats DROP COLUMN " + column).Error; err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	)
+}
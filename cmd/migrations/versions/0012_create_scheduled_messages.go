@@ -0,0 +1,34 @@
+package versions
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type scheduledMessage0012 struct {
+	ID        uint          `gorm:"primaryKey"`
+	GroupID   uint          `gorm:"index"`
+	ChatGroup chatGroup0001 `gorm:"foreignKey:GroupID;constraint:OnDelete:CASCADE"`
+	UserID    uint          `gorm:"index"`
+	User      user0001      `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+	Message   string        `gorm:"not null"`
+	SendAt    time.Time     `gorm:"not null;index:idx_scheduled_messages_status_send_at,priority:2"`
+	Status    string        `gorm:"size:16;not null;default:pending;index:idx_scheduled_messages_status_send_at,priority:1"`
+	ChatID    *uint
+	Error     string
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+func (scheduledMessage0012) TableName() string { return "scheduled_messages" }
+
+func init() {
+	register(
+		func(tx *gorm.DB) error {
+			return tx.Migrator().CreateTable(&scheduledMessage0012{})
+		},
+		func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable("scheduled_messages")
+		},
+	)
+}
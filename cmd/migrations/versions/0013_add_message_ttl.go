@@ -0,0 +1,43 @@
+package versions
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type chatGroup0013 struct {
+	MessageTTLSeconds int64 `gorm:"not null;default:0"`
+}
+
+func (chatGroup0013) TableName() string { return "chat_groups" }
+
+type chat0013 struct {
+	ExpiresAt *time.Time
+}
+
+func (chat0013) TableName() string { return "chats" }
+
+func init() {
+	register(
+		func(tx *gorm.DB) error {
+			if err := tx.Migrator().AddColumn(&chatGroup0013{}, "MessageTTLSeconds"); err != nil {
+				return err
+			}
+			if err := tx.Migrator().AddColumn(&chat0013{}, "ExpiresAt"); err != nil {
+				return err
+			}
+			return tx.Exec("CREATE INDEX idx_chats_expires_at ON chats (expires_at)").Error
+		},
+		func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropIndex("chats", "idx_chats_expires_at"); err != nil {
+				return err
+			}
+			// DROP COLUMN langsung, lihat 0006
+			if err := tx.Exec("ALTER TABLE chats DROP COLUMN expires_at").Error; err != nil {
+				return err
+			}
+			return tx.Exec("ALTER TABLE chat_groups DROP COLUMN message_ttl_seconds").Error
+		},
+	)
+}
@@ -0,0 +1,34 @@
+package versions
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type groupInvite0014 struct {
+	ID            uint             `gorm:"primaryKey"`
+	Code          string           `gorm:"size:32;not null;uniqueIndex"`
+	GroupID       uint             `gorm:"index"`
+	ChatGroup     chatGroup0001    `gorm:"foreignKey:GroupID;constraint:OnDelete:CASCADE"`
+	GroupMemberID *uint            `gorm:"index"`
+	GroupMember   *groupMember0001 `gorm:"foreignKey:GroupMemberID;constraint:OnDelete:SET NULL"`
+	Role          string           `gorm:"not null;default:member"`
+	MaxUses       int              `gorm:"not null;default:0"`
+	Uses          int              `gorm:"not null;default:0"`
+	ExpiresAt     *time.Time
+	CreatedAt     time.Time `gorm:"autoCreateTime"`
+}
+
+func (groupInvite0014) TableName() string { return "group_invites" }
+
+func init() {
+	register(
+		func(tx *gorm.DB) error {
+			return tx.Migrator().CreateTable(&groupInvite0014{})
+		},
+		func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable("group_invites")
+		},
+	)
+}
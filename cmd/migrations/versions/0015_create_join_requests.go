@@ -0,0 +1,34 @@
+package versions
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type joinRequest0015 struct {
+	ID            uint             `gorm:"primaryKey"`
+	GroupID       uint             `gorm:"index:idx_join_requests_group_status,priority:1"`
+	ChatGroup     chatGroup0001    `gorm:"foreignKey:GroupID;constraint:OnDelete:CASCADE"`
+	UserID        uint             `gorm:"index"`
+	User          user0001         `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+	Note          string
+	Status        string           `gorm:"size:16;not null;default:pending;index:idx_join_requests_group_status,priority:2"`
+	GroupMemberID *uint            `gorm:"index"`
+	GroupMember   *groupMember0001 `gorm:"foreignKey:GroupMemberID;constraint:OnDelete:SET NULL"`
+	ReviewedAt    *time.Time
+	CreatedAt     time.Time `gorm:"autoCreateTime"`
+}
+
+func (joinRequest0015) TableName() string { return "join_requests" }
+
+func init() {
+	register(
+		func(tx *gorm.DB) error {
+			return tx.Migrator().CreateTable(&joinRequest0015{})
+		},
+		func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable("join_requests")
+		},
+	)
+}
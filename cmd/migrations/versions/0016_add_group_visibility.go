@@ -0,0 +1,29 @@
+package versions
+
+import (
+	"gorm.io/gorm"
+)
+
+type chatGroup0016 struct {
+	Visibility string `gorm:"size:16;not null;default:private"`
+}
+
+func (chatGroup0016) TableName() string { return "chat_groups" }
+
+func init() {
+	register(
+		func(tx *gorm.DB) error {
+			if err := tx.Migrator().AddColumn(&chatGroup0016{}, "Visibility"); err != nil {
+				return err
+			}
+			return tx.Exec("CREATE INDEX idx_chat_groups_visibility ON chat_groups (visibility)").Error
+		},
+		func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropIndex("chat_groups", "idx_chat_groups_visibility"); err != nil {
+				return err
+			}
+			// DROP COLUMN langsung, lihat 0006
+			return tx.Exec("ALTER TABLE chat_groups DROP COLUMN visibility").Error
+		},
+	)
+}
@@ -0,0 +1,36 @@
+package versions
+
+import (
+	"gorm.io/gorm"
+)
+
+// avatar_attachment_id has no foreign key, see 0006
+type user0017 struct {
+	DisplayName        string `gorm:"size:64"`
+	Bio                string `gorm:"size:500"`
+	AvatarAttachmentID *uint
+}
+
+func (user0017) TableName() string { return "users" }
+
+func init() {
+	register(
+		func(tx *gorm.DB) error {
+			for _, column := range []string{"DisplayName", "Bio", "AvatarAttachmentID"} {
+				if err := tx.Migrator().AddColumn(&user0017{}, column); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		func(tx *gorm.DB) error {
+			// DROP COLUMN langsung, lihat 0006
+			for _, column := range []string{"display_name", "bio", "avatar_attachment_id"} {
+				if err := tx.Exec("ALTER TABLE users DROP COLUMN " + column).Error; err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	)
+}
@@ -0,0 +1,42 @@
+package versions
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type user0018 struct {
+	Discoverable bool `gorm:"not null;default:true"`
+}
+
+func (user0018) TableName() string { return "users" }
+
+type userBlock0018 struct {
+	ID            uint      `gorm:"primaryKey"`
+	UserID        uint      `gorm:"uniqueIndex:idx_user_blocks_pair,priority:1"`
+	User          user0001  `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+	BlockedUserID uint      `gorm:"uniqueIndex:idx_user_blocks_pair,priority:2;index"`
+	BlockedUser   user0001  `gorm:"foreignKey:BlockedUserID;constraint:OnDelete:CASCADE"`
+	CreatedAt     time.Time `gorm:"autoCreateTime"`
+}
+
+func (userBlock0018) TableName() string { return "user_blocks" }
+
+func init() {
+	register(
+		func(tx *gorm.DB) error {
+			if err := tx.Migrator().AddColumn(&user0018{}, "Discoverable"); err != nil {
+				return err
+			}
+			return tx.Migrator().CreateTable(&userBlock0018{})
+		},
+		func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropTable("user_blocks"); err != nil {
+				return err
+			}
+			// DROP COLUMN langsung, lihat 0006
+			return tx.Exec("ALTER TABLE users DROP COLUMN discoverable").Error
+		},
+	)
+}
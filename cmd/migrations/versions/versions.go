@@ -0,0 +1,50 @@
+package versions
+
+import (
+	"chat_api/cmd/migrations/migrate"
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"runtime"
+
+	"gorm.io/gorm"
+)
+
+// source migrasi ikut di-embed supaya checksum bisa dihitung dari isi file
+//
+//go:embed *.go
+var sources embed.FS
+
+var migrations []migrate.Migration
+
+// register adds the migration defined in the calling file. Version and name
+// come from the file name (NNNN_name.go) and the checksum from its source.
+func register(up, down func(tx *gorm.DB) error) {
+	_, file, _, _ := runtime.Caller(1)
+	base := filepath.Base(file)
+
+	version, name, err := migrate.ParseFileName(base)
+	if err != nil {
+		panic(err)
+	}
+
+	src, err := sources.ReadFile(base)
+	if err != nil {
+		panic(fmt.Sprintf("migration source %s not embedded: %v", base, err))
+	}
+	sum := sha256.Sum256(src)
+
+	migrations = append(migrations, migrate.Migration{
+		Version:  version,
+		Name:     name,
+		Checksum: hex.EncodeToString(sum[:]),
+		Up:       up,
+		Down:     down,
+	})
+}
+
+func All() []migrate.Migration {
+	return migrations
+}
@@ -0,0 +1,8 @@
+package docs
+
+import _ "embed"
+
+// dokumen OpenAPI hasil protoc-gen-openapiv2 (make proto)
+//
+//go:embed chat_api.swagger.json
+var OpenAPI []byte
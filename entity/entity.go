@@ -2,6 +2,8 @@ package entity
 
 import (
 	"time"
+
+	"gorm.io/gorm"
 )
 
 type User struct {
@@ -11,6 +13,23 @@ type User struct {
 	Password   string    `gorm:"not null"`
 	IsVerified bool      `gorm:"default:false"`
 	CreatedAt  time.Time `gorm:"autoCreateTime"`
+
+	DisplayName string `gorm:"size:64"`
+	Bio         string `gorm:"size:500"`
+	// attachment gambar yang di-upload user sendiri, tanpa foreign key
+	AvatarAttachmentID *uint
+	// false berarti tidak muncul di SearchUsers
+	Discoverable bool `gorm:"not null;default:true"`
+}
+
+// UserBlock: UserID memblokir BlockedUserID
+type UserBlock struct {
+	ID            uint      `gorm:"primaryKey"`
+	UserID        uint      `gorm:"uniqueIndex:idx_user_blocks_pair,priority:1"`
+	User          User      `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+	BlockedUserID uint      `gorm:"uniqueIndex:idx_user_blocks_pair,priority:2;index"`
+	BlockedUser   User      `gorm:"foreignKey:BlockedUserID;constraint:OnDelete:CASCADE"`
+	CreatedAt     time.Time `gorm:"autoCreateTime"`
 }
 
 // chat
@@ -20,33 +39,209 @@ type ChatGroup struct {
 	Description string
 	LastMessage string
 	UnreadCount int
-	Members     []GroupMember `gorm:"foreignKey:GroupID;constraint:OnDelete:CASCADE"`
-	Chats       []Chat        `gorm:"foreignKey:GroupID;constraint:OnDelete:CASCADE"`
+	// disappearing message dalam detik, 0 berarti mati
+	MessageTTLSeconds int64 `gorm:"not null;default:0"`
+	// public bisa dicari dan langsung diikuti, private lewat invite / join request
+	Visibility string        `gorm:"size:16;not null;default:private;index"`
+	Members    []GroupMember `gorm:"foreignKey:GroupID;constraint:OnDelete:CASCADE"`
+	Chats      []Chat        `gorm:"foreignKey:GroupID;constraint:OnDelete:CASCADE"`
 }
 
+// visibility ChatGroup, grup public bisa dicari dan diikuti tanpa undangan
+const (
+	GroupPrivate = "private"
+	GroupPublic  = "public"
+)
+
 type GroupMember struct {
 	ID        uint      `gorm:"primaryKey"`
-	UserID    uint      `gorm:"index"`
+	UserID    uint      `gorm:"index;index:idx_group_members_user_group,priority:1"`
 	User      User      `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
-	GroupID   uint      `gorm:"index"`
+	GroupID   uint      `gorm:"index;index:idx_group_members_user_group,priority:2"`
 	Role      string    `gorm:"not null"`
 	ChatGroup ChatGroup `gorm:"foreignKey:GroupID;constraint:OnDelete:CASCADE"`
 }
 
 type Chat struct {
+	ID            uint           `gorm:"primaryKey"`
+	GroupMemberID *uint          `gorm:"index"`
+	GroupMember   *GroupMember   `gorm:"foreignKey:GroupMemberID;constraint:OnDelete:SET NULL"`
+	Message       string         `gorm:"not null"`
+	GroupID       uint           `gorm:"index;index:idx_chats_group_created,priority:1"`
+	CreatedAt     time.Time      `gorm:"autoCreateTime;index:idx_chats_group_created,priority:2"`
+	ReadStatus    []ChatRead     `gorm:"foreignKey:ChatID;constraint:OnDelete:CASCADE"`
+	Attachments   []Attachment   `gorm:"foreignKey:ChatID;constraint:OnDelete:CASCADE"`
+	Reactions     []Reaction     `gorm:"foreignKey:ChatID;constraint:OnDelete:CASCADE"`
+	Mentions      []Mention      `gorm:"foreignKey:ChatID;constraint:OnDelete:CASCADE"`
+	Pin           *Pin           `gorm:"foreignKey:ChatID;constraint:OnDelete:CASCADE"`
+	Revisions     []ChatRevision `gorm:"foreignKey:ChatID;constraint:OnDelete:CASCADE"`
+	EditedAt      *time.Time
+	// diisi dari MessageTTLSeconds grup saat chat dibuat, lalu dihapus janitor
+	ExpiresAt *time.Time `gorm:"index:idx_chats_expires_at"`
+
+	// reply & thread, tanpa foreign key di database (dibersihkan saat chat dihapus)
+	ReplyToID        *uint `gorm:"index:idx_chats_reply_to"`
+	ReplyTo          *Chat `gorm:"foreignKey:ReplyToID"`
+	ThreadRootID     *uint `gorm:"index:idx_chats_thread_root"`
+	ThreadReplyCount int   `gorm:"not null;default:0"`
+	LastReplyAt      *time.Time
+
+	// soft delete, history tetap mengirim tombstone sampai di-purge admin.
+	// DeletedByID adalah member yang menghapus, tanpa foreign key seperti ReplyToID
+	DeletedAt   gorm.DeletedAt `gorm:"index:idx_chats_deleted_at"`
+	DeletedByID *uint
+
+	// forward, atribusi ke chat asli. Tanpa foreign key dan nama ikut disalin
+	// karena chat/grup asal bisa dihapus dan tidak bisa dibuka member grup tujuan
+	ForwardedFromChatID    *uint
+	ForwardedFromGroupID   *uint
+	ForwardedFromUserID    *uint
+	ForwardedFromUsername  string
+	ForwardedFromGroupName string
+
+	// tidak disimpan, diisi usecase kalau user yang melihat memblokir pengirim
+	SenderBlocked bool `gorm:"-"`
+}
+
+type ChatRead struct {
+	ID            uint        `gorm:"primaryKey"`
+	GroupMemberID uint        `gorm:"uniqueIndex:idx_chat;index:idx_chat_reads_member_read,priority:1"`
+	GroupMember   GroupMember `gorm:"foreignKey:GroupMemberID;OnDelete:CASCADE"`
+	ChatID        uint        `gorm:"uniqueIndex:idx_chat"`
+	IsRead        bool        `gorm:"default:false;index:idx_chat_reads_member_read,priority:2"`
+}
+
+// attachment diupload dulu (ChatID nil), lalu ditempel ke chat saat CreateChat
+type Attachment struct {
 	ID            uint         `gorm:"primaryKey"`
+	GroupID       uint         `gorm:"index"`
+	ChatGroup     ChatGroup    `gorm:"foreignKey:GroupID;constraint:OnDelete:CASCADE"`
 	GroupMemberID *uint        `gorm:"index"`
 	GroupMember   *GroupMember `gorm:"foreignKey:GroupMemberID;constraint:OnDelete:SET NULL"`
-	Message       string       `gorm:"not null"`
+	ChatID        *uint        `gorm:"index"`
+	FileName      string       `gorm:"not null"`
+	ContentType   string       `gorm:"not null"`
+	Size          int64        `gorm:"not null"`
+	Checksum      string       `gorm:"size:64;not null"`
+	StorageKey    string       `gorm:"size:255;not null;unique"`
+	Width         int
+	Height        int
+	Blurhash      string                `gorm:"size:64"`
+	Status        string                `gorm:"size:16;not null;default:ready"`
+	Thumbnails    []AttachmentThumbnail `gorm:"foreignKey:AttachmentID;constraint:OnDelete:CASCADE"`
+	CreatedAt     time.Time             `gorm:"autoCreateTime"`
+}
+
+// status Attachment, gambar mulai dari processing sampai pipeline selesai
+const (
+	AttachmentProcessing = "processing"
+	AttachmentReady      = "ready"
+	AttachmentFailed     = "failed"
+)
+
+type AttachmentThumbnail struct {
+	ID           uint   `gorm:"primaryKey"`
+	AttachmentID uint   `gorm:"uniqueIndex:idx_attachment_thumbnail_size"`
+	Size         int    `gorm:"uniqueIndex:idx_attachment_thumbnail_size"`
+	Width        int    `gorm:"not null"`
+	Height       int    `gorm:"not null"`
+	StorageKey   string `gorm:"size:255;not null;unique"`
+}
+
+// satu member hanya bisa memberi emoji yang sama sekali per chat
+type Reaction struct {
+	ID            uint        `gorm:"primaryKey"`
+	ChatID        uint        `gorm:"uniqueIndex:idx_reaction_member_emoji"`
+	GroupMemberID uint        `gorm:"uniqueIndex:idx_reaction_member_emoji;index"`
+	GroupMember   GroupMember `gorm:"foreignKey:GroupMemberID;constraint:OnDelete:CASCADE"`
+	Emoji         string      `gorm:"size:32;not null;uniqueIndex:idx_reaction_member_emoji"`
+	CreatedAt     time.Time   `gorm:"autoCreateTime"`
+}
+
+// GroupMemberID adalah member yang di-mention
+type Mention struct {
+	ID            uint        `gorm:"primaryKey"`
+	ChatID        uint        `gorm:"uniqueIndex:idx_mention_chat_member"`
+	Chat          *Chat       `gorm:"foreignKey:ChatID;constraint:OnDelete:CASCADE"`
+	GroupMemberID uint        `gorm:"uniqueIndex:idx_mention_chat_member;index:idx_mentions_member_read,priority:1"`
+	GroupMember   GroupMember `gorm:"foreignKey:GroupMemberID;constraint:OnDelete:CASCADE"`
+	IsRead        bool        `gorm:"default:false;index:idx_mentions_member_read,priority:2"`
+	CreatedAt     time.Time   `gorm:"autoCreateTime"`
+}
+
+// GroupMemberID adalah admin yang melakukan pin
+type Pin struct {
+	ID            uint         `gorm:"primaryKey"`
+	ChatID        uint         `gorm:"uniqueIndex"`
 	GroupID       uint         `gorm:"index"`
+	ChatGroup     ChatGroup    `gorm:"foreignKey:GroupID;constraint:OnDelete:CASCADE"`
+	GroupMemberID *uint        `gorm:"index"`
+	GroupMember   *GroupMember `gorm:"foreignKey:GroupMemberID;constraint:OnDelete:SET NULL"`
 	CreatedAt     time.Time    `gorm:"autoCreateTime"`
-	ReadStatus    []ChatRead   `gorm:"foreignKey:ChatID;constraint:OnDelete:CASCADE"`
 }
 
-type ChatRead struct {
-	ID            uint        `gorm:"primaryKey"`
-	GroupMemberID uint        `gorm:"uniqueIndex:idx_chat"`
-	GroupMember   GroupMember `gorm:"foreignKey:GroupMemberID;OnDelete:CASCADE"`
-	ChatID        uint        `gorm:"uniqueIndex:idx_chat"`
-	IsRead        bool        `gorm:"default:false"`
+// ChatRevision menyimpan isi chat sebelum diedit. GroupMemberID adalah editor
+type ChatRevision struct {
+	ID            uint         `gorm:"primaryKey"`
+	ChatID        uint         `gorm:"index"`
+	Message       string       `gorm:"not null"`
+	GroupMemberID *uint        `gorm:"index"`
+	GroupMember   *GroupMember `gorm:"foreignKey:GroupMemberID;constraint:OnDelete:SET NULL"`
+	CreatedAt     time.Time    `gorm:"autoCreateTime"`
+}
+
+// pesan terjadwal, member penulis dicari ulang dari UserID saat dikirim
+type ScheduledMessage struct {
+	ID        uint      `gorm:"primaryKey"`
+	GroupID   uint      `gorm:"index"`
+	ChatGroup ChatGroup `gorm:"foreignKey:GroupID;constraint:OnDelete:CASCADE"`
+	UserID    uint      `gorm:"index"`
+	User      User      `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+	Message   string    `gorm:"not null"`
+	SendAt    time.Time `gorm:"not null;index:idx_scheduled_messages_status_send_at,priority:2"`
+	Status    string    `gorm:"size:16;not null;default:pending;index:idx_scheduled_messages_status_send_at,priority:1"`
+	ChatID    *uint
+	Error     string
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+// status ScheduledMessage
+const (
+	ScheduledPending  = "pending"
+	ScheduledSending  = "sending"
+	ScheduledSent     = "sent"
+	ScheduledFailed   = "failed"
+	ScheduledCanceled = "canceled"
+)
+
+// GroupInvite adalah kode undangan grup. MaxUses 0 berarti tanpa batas,
+// ExpiresAt nil berarti tidak kedaluwarsa. GroupMemberID adalah admin pembuat
+type GroupInvite struct {
+	ID            uint         `gorm:"primaryKey"`
+	Code          string       `gorm:"size:32;not null;uniqueIndex"`
+	GroupID       uint         `gorm:"index"`
+	ChatGroup     ChatGroup    `gorm:"foreignKey:GroupID;constraint:OnDelete:CASCADE"`
+	GroupMemberID *uint        `gorm:"index"`
+	GroupMember   *GroupMember `gorm:"foreignKey:GroupMemberID;constraint:OnDelete:SET NULL"`
+	Role          string       `gorm:"not null;default:member"`
+	MaxUses       int          `gorm:"not null;default:0"`
+	Uses          int          `gorm:"not null;default:0"`
+	ExpiresAt     *time.Time
+	CreatedAt     time.Time `gorm:"autoCreateTime"`
+}
+
+// JoinRequest adalah permintaan bergabung ke grup. GroupMemberID adalah admin
+// yang menyetujui atau menolak
+type JoinRequest struct {
+	ID            uint      `gorm:"primaryKey"`
+	GroupID       uint      `gorm:"index:idx_join_requests_group_status,priority:1"`
+	ChatGroup     ChatGroup `gorm:"foreignKey:GroupID;constraint:OnDelete:CASCADE"`
+	UserID        uint      `gorm:"index"`
+	User          User      `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+	Note          string
+	Status        string       `gorm:"size:16;not null;default:pending;index:idx_join_requests_group_status,priority:2"`
+	GroupMemberID *uint        `gorm:"index"`
+	GroupMember   *GroupMember `gorm:"foreignKey:GroupMemberID;constraint:OnDelete:SET NULL"`
+	ReviewedAt    *time.Time
+	CreatedAt     time.Time `gorm:"autoCreateTime"`
 }
@@ -8,6 +8,8 @@ package pb
 
 import (
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -226,7 +228,7 @@ var File_proto_auth_proto protoreflect.FileDescriptor
 
 const file_proto_auth_proto_rawDesc = "" +
 	"\n" +
-	"\x10proto/auth.proto\x12\x02pb\x1a\x1dutils/validate/validate.proto\"T\n" +
+	"\x10proto/auth.proto\x12\x02pb\x1a\x1dutils/validate/validate.proto\x1a\"utils/google/api/annotations.proto\x1a)utils/openapiv2/options/annotations.proto\"T\n" +
 	"\fLoginRequest\x12\x1f\n" +
 	"\x05email\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x06`\x01R\x05email\x12#\n" +
 	"\bpassword\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x03R\bpassword\"%\n" +
@@ -237,10 +239,10 @@ const file_proto_auth_proto_rawDesc = "" +
 	"\bpassword\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\bpassword\x12$\n" +
 	"\busername\x18\x03 \x01(\tB\b\xfaB\x05r\x03\xd0\x01\x01R\busername\",\n" +
 	"\x10RegisterResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage2k\n" +
-	"\x04Auth\x12,\n" +
-	"\x05Login\x12\x10.pb.LoginRequest\x1a\x11.pb.LoginResponse\x125\n" +
-	"\bRegister\x12\x13.pb.RegisterRequest\x1a\x14.pb.RegisterResponseB\x05Z\x03/pbb\x06proto3"
+	"\amessage\x18\x01 \x01(\tR\amessage2\xae\x01\n" +
+	"\x04Auth\x12L\n" +
+	"\x05Login\x12\x10.pb.LoginRequest\x1a\x11.pb.LoginResponse\"\x1e\x92A\x02b\x00\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/auth/login\x12X\n" +
+	"\bRegister\x12\x13.pb.RegisterRequest\x1a\x14.pb.RegisterResponse\"!\x92A\x02b\x00\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/auth/registerB\x05Z\x03/pbb\x06proto3"
 
 var (
 	file_proto_auth_proto_rawDescOnce sync.Once
@@ -8,6 +8,8 @@ package pb
 
 import (
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
@@ -29,19 +31,58 @@ const (
 	Action_Create Action = 0
 	Action_Update Action = 1
 	Action_Delete Action = 2
+	// thumbnail dan metadata gambar selesai diproses, lihat attachments
+	Action_AttachmentReady Action = 3
+	// reaction berubah, lihat reactions
+	Action_Reaction Action = 4
+	// jumlah balasan / waktu balasan terakhir root thread berubah
+	Action_ThreadUpdate Action = 5
+	// chat di-pin / di-unpin, lihat pinned
+	Action_Pin   Action = 6
+	Action_Unpin Action = 7
+	// member mulai / berhenti mengetik, lihat typing
+	Action_Typing Action = 8
+	// chat dihapus permanen oleh admin, hilangkan dari tampilan
+	Action_Purge Action = 9
+	// member baru bergabung lewat invite, lihat member dan username
+	Action_Join Action = 10
+	// profil member berubah, lihat profile
+	Action_ProfileUpdate Action = 11
+	// gambar gagal diproses dan original-nya dihapus, lihat attachments
+	Action_AttachmentFailed Action = 12
 )
 
 // Enum value maps for Action.
 var (
 	Action_name = map[int32]string{
-		0: "Create",
-		1: "Update",
-		2: "Delete",
+		0:  "Create",
+		1:  "Update",
+		2:  "Delete",
+		3:  "AttachmentReady",
+		4:  "Reaction",
+		5:  "ThreadUpdate",
+		6:  "Pin",
+		7:  "Unpin",
+		8:  "Typing",
+		9:  "Purge",
+		10: "Join",
+		11: "ProfileUpdate",
+		12: "AttachmentFailed",
 	}
 	Action_value = map[string]int32{
-		"Create": 0,
-		"Update": 1,
-		"Delete": 2,
+		"Create":           0,
+		"Update":           1,
+		"Delete":           2,
+		"AttachmentReady":  3,
+		"Reaction":         4,
+		"ThreadUpdate":     5,
+		"Pin":              6,
+		"Unpin":            7,
+		"Typing":           8,
+		"Purge":            9,
+		"Join":             10,
+		"ProfileUpdate":    11,
+		"AttachmentFailed": 12,
 	}
 )
 
@@ -72,12 +113,77 @@ func (Action) EnumDescriptor() ([]byte, []int) {
 	return file_proto_chat_proto_rawDescGZIP(), []int{0}
 }
 
+// jenis event di status stream
+type StatusEvent int32
+
+const (
+	// status online/offline member
+	StatusEvent_Presence StatusEvent = 0
+	// ada join request baru, hanya dikirim ke admin, lihat join_request
+	StatusEvent_JoinRequested StatusEvent = 1
+	// join request disetujui / ditolak admin lain
+	StatusEvent_JoinRequestResolved StatusEvent = 2
+	// profil member berubah, lihat profile
+	StatusEvent_ProfileUpdated StatusEvent = 3
+	// member mulai / berhenti mengetik, lihat typing. status tidak diisi
+	StatusEvent_TypingChanged StatusEvent = 4
+)
+
+// Enum value maps for StatusEvent.
+var (
+	StatusEvent_name = map[int32]string{
+		0: "Presence",
+		1: "JoinRequested",
+		2: "JoinRequestResolved",
+		3: "ProfileUpdated",
+		4: "TypingChanged",
+	}
+	StatusEvent_value = map[string]int32{
+		"Presence":            0,
+		"JoinRequested":       1,
+		"JoinRequestResolved": 2,
+		"ProfileUpdated":      3,
+		"TypingChanged":       4,
+	}
+)
+
+func (x StatusEvent) Enum() *StatusEvent {
+	p := new(StatusEvent)
+	*p = x
+	return p
+}
+
+func (x StatusEvent) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatusEvent) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_chat_proto_enumTypes[1].Descriptor()
+}
+
+func (StatusEvent) Type() protoreflect.EnumType {
+	return &file_proto_chat_proto_enumTypes[1]
+}
+
+func (x StatusEvent) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatusEvent.Descriptor instead.
+func (StatusEvent) EnumDescriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{1}
+}
+
 // write chat
 type CreateChatRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GroupId       uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Status        []*AnyUserStatus       `protobuf:"bytes,3,rep,name=status,proto3" json:"status,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	GroupId uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// boleh kosong kalau ada attachment
+	Message       string           `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Status        []*AnyUserStatus `protobuf:"bytes,3,rep,name=status,proto3" json:"status,omitempty"`
+	AttachmentIds []uint64         `protobuf:"varint,4,rep,packed,name=attachment_ids,json=attachmentIds,proto3" json:"attachment_ids,omitempty"`
+	// balas chat lain di grup yang sama, otomatis masuk thread chat tersebut
+	ReplyToId     uint64 `protobuf:"varint,5,opt,name=reply_to_id,json=replyToId,proto3" json:"reply_to_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -133,6 +239,20 @@ func (x *CreateChatRequest) GetStatus() []*AnyUserStatus {
 	return nil
 }
 
+func (x *CreateChatRequest) GetAttachmentIds() []uint64 {
+	if x != nil {
+		return x.AttachmentIds
+	}
+	return nil
+}
+
+func (x *CreateChatRequest) GetReplyToId() uint64 {
+	if x != nil {
+		return x.ReplyToId
+	}
+	return 0
+}
+
 type AnyUserStatus struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	MemberId      uint64                 `protobuf:"varint,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
@@ -281,29 +401,30 @@ func (x *DeleteChatRequest) GetChatId() uint64 {
 	return 0
 }
 
-type UpdateChatRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GroupId       uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	ChatId        uint64                 `protobuf:"varint,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ForwardMessagesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// grup asal
+	GroupId        uint64   `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	ChatIds        []uint64 `protobuf:"varint,2,rep,packed,name=chat_ids,json=chatIds,proto3" json:"chat_ids,omitempty"`
+	TargetGroupIds []uint64 `protobuf:"varint,3,rep,packed,name=target_group_ids,json=targetGroupIds,proto3" json:"target_group_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *UpdateChatRequest) Reset() {
-	*x = UpdateChatRequest{}
+func (x *ForwardMessagesRequest) Reset() {
+	*x = ForwardMessagesRequest{}
 	mi := &file_proto_chat_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateChatRequest) String() string {
+func (x *ForwardMessagesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateChatRequest) ProtoMessage() {}
+func (*ForwardMessagesRequest) ProtoMessage() {}
 
-func (x *UpdateChatRequest) ProtoReflect() protoreflect.Message {
+func (x *ForwardMessagesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_chat_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -315,55 +436,54 @@ func (x *UpdateChatRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateChatRequest.ProtoReflect.Descriptor instead.
-func (*UpdateChatRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ForwardMessagesRequest.ProtoReflect.Descriptor instead.
+func (*ForwardMessagesRequest) Descriptor() ([]byte, []int) {
 	return file_proto_chat_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *UpdateChatRequest) GetGroupId() uint64 {
+func (x *ForwardMessagesRequest) GetGroupId() uint64 {
 	if x != nil {
 		return x.GroupId
 	}
 	return 0
 }
 
-func (x *UpdateChatRequest) GetChatId() uint64 {
+func (x *ForwardMessagesRequest) GetChatIds() []uint64 {
 	if x != nil {
-		return x.ChatId
+		return x.ChatIds
 	}
-	return 0
+	return nil
 }
 
-func (x *UpdateChatRequest) GetMessage() string {
+func (x *ForwardMessagesRequest) GetTargetGroupIds() []uint64 {
 	if x != nil {
-		return x.Message
+		return x.TargetGroupIds
 	}
-	return ""
+	return nil
 }
 
-// write group & member
-type CreateGroupRequest struct {
+type ForwardedChat struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Desc          string                 `protobuf:"bytes,2,opt,name=desc,proto3" json:"desc,omitempty"`
+	GroupId       uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	ChatId        uint64                 `protobuf:"varint,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateGroupRequest) Reset() {
-	*x = CreateGroupRequest{}
+func (x *ForwardedChat) Reset() {
+	*x = ForwardedChat{}
 	mi := &file_proto_chat_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateGroupRequest) String() string {
+func (x *ForwardedChat) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGroupRequest) ProtoMessage() {}
+func (*ForwardedChat) ProtoMessage() {}
 
-func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
+func (x *ForwardedChat) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_chat_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -375,46 +495,46 @@ func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGroupRequest.ProtoReflect.Descriptor instead.
-func (*CreateGroupRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ForwardedChat.ProtoReflect.Descriptor instead.
+func (*ForwardedChat) Descriptor() ([]byte, []int) {
 	return file_proto_chat_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *CreateGroupRequest) GetName() string {
+func (x *ForwardedChat) GetGroupId() uint64 {
 	if x != nil {
-		return x.Name
+		return x.GroupId
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateGroupRequest) GetDesc() string {
+func (x *ForwardedChat) GetChatId() uint64 {
 	if x != nil {
-		return x.Desc
+		return x.ChatId
 	}
-	return ""
+	return 0
 }
 
-type DeleteGroupRequest struct {
+type ForwardMessagesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GroupId       uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Chats         []*ForwardedChat       `protobuf:"bytes,1,rep,name=chats,proto3" json:"chats,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteGroupRequest) Reset() {
-	*x = DeleteGroupRequest{}
+func (x *ForwardMessagesResponse) Reset() {
+	*x = ForwardMessagesResponse{}
 	mi := &file_proto_chat_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteGroupRequest) String() string {
+func (x *ForwardMessagesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGroupRequest) ProtoMessage() {}
+func (*ForwardMessagesResponse) ProtoMessage() {}
 
-func (x *DeleteGroupRequest) ProtoReflect() protoreflect.Message {
+func (x *ForwardMessagesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_chat_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -426,41 +546,40 @@ func (x *DeleteGroupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGroupRequest.ProtoReflect.Descriptor instead.
-func (*DeleteGroupRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ForwardMessagesResponse.ProtoReflect.Descriptor instead.
+func (*ForwardMessagesResponse) Descriptor() ([]byte, []int) {
 	return file_proto_chat_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *DeleteGroupRequest) GetGroupId() uint64 {
+func (x *ForwardMessagesResponse) GetChats() []*ForwardedChat {
 	if x != nil {
-		return x.GroupId
+		return x.Chats
 	}
-	return 0
+	return nil
 }
 
-type UpdateGroupRequest struct {
+type GetMessageRevisionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	GroupId       uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Desc          string                 `protobuf:"bytes,3,opt,name=desc,proto3" json:"desc,omitempty"`
+	ChatId        uint64                 `protobuf:"varint,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateGroupRequest) Reset() {
-	*x = UpdateGroupRequest{}
+func (x *GetMessageRevisionsRequest) Reset() {
+	*x = GetMessageRevisionsRequest{}
 	mi := &file_proto_chat_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateGroupRequest) String() string {
+func (x *GetMessageRevisionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateGroupRequest) ProtoMessage() {}
+func (*GetMessageRevisionsRequest) ProtoMessage() {}
 
-func (x *UpdateGroupRequest) ProtoReflect() protoreflect.Message {
+func (x *GetMessageRevisionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_chat_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -472,55 +591,51 @@ func (x *UpdateGroupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateGroupRequest.ProtoReflect.Descriptor instead.
-func (*UpdateGroupRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetMessageRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*GetMessageRevisionsRequest) Descriptor() ([]byte, []int) {
 	return file_proto_chat_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *UpdateGroupRequest) GetGroupId() uint64 {
+func (x *GetMessageRevisionsRequest) GetGroupId() uint64 {
 	if x != nil {
 		return x.GroupId
 	}
 	return 0
 }
 
-func (x *UpdateGroupRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *UpdateGroupRequest) GetDesc() string {
+func (x *GetMessageRevisionsRequest) GetChatId() uint64 {
 	if x != nil {
-		return x.Desc
+		return x.ChatId
 	}
-	return ""
+	return 0
 }
 
-type UpdateRoleUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	MemberId      uint64                 `protobuf:"varint,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
-	GroupId       uint64                 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// message adalah isi chat sebelum edit oleh edited_by pada edited_at
+type MessageRevision struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Message        string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	EditedBy       uint64                 `protobuf:"varint,3,opt,name=edited_by,json=editedBy,proto3" json:"edited_by,omitempty"`
+	EditorUsername string                 `protobuf:"bytes,4,opt,name=editor_username,json=editorUsername,proto3" json:"editor_username,omitempty"`
+	EditedAt       string                 `protobuf:"bytes,5,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *UpdateRoleUserRequest) Reset() {
-	*x = UpdateRoleUserRequest{}
+func (x *MessageRevision) Reset() {
+	*x = MessageRevision{}
 	mi := &file_proto_chat_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateRoleUserRequest) String() string {
+func (x *MessageRevision) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateRoleUserRequest) ProtoMessage() {}
+func (*MessageRevision) ProtoMessage() {}
 
-func (x *UpdateRoleUserRequest) ProtoReflect() protoreflect.Message {
+func (x *MessageRevision) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_chat_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -532,54 +647,71 @@ func (x *UpdateRoleUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateRoleUserRequest.ProtoReflect.Descriptor instead.
-func (*UpdateRoleUserRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MessageRevision.ProtoReflect.Descriptor instead.
+func (*MessageRevision) Descriptor() ([]byte, []int) {
 	return file_proto_chat_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *UpdateRoleUserRequest) GetMemberId() uint64 {
+func (x *MessageRevision) GetId() uint64 {
 	if x != nil {
-		return x.MemberId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *UpdateRoleUserRequest) GetGroupId() uint64 {
+func (x *MessageRevision) GetMessage() string {
 	if x != nil {
-		return x.GroupId
+		return x.Message
+	}
+	return ""
+}
+
+func (x *MessageRevision) GetEditedBy() uint64 {
+	if x != nil {
+		return x.EditedBy
 	}
 	return 0
 }
 
-func (x *UpdateRoleUserRequest) GetRole() string {
+func (x *MessageRevision) GetEditorUsername() string {
 	if x != nil {
-		return x.Role
+		return x.EditorUsername
 	}
 	return ""
 }
 
-type AddMemberRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GroupId       uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	ListUserId    []*ListUserId          `protobuf:"bytes,2,rep,name=list_user_id,json=listUserId,proto3" json:"list_user_id,omitempty"`
+func (x *MessageRevision) GetEditedAt() string {
+	if x != nil {
+		return x.EditedAt
+	}
+	return ""
+}
+
+type GetMessageRevisionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Chat  *ChatStreamingResponse `protobuf:"bytes,1,opt,name=chat,proto3" json:"chat,omitempty"`
+	// isi chat saat ini, tetap terisi untuk chat yang sudah dihapus
+	CurrentMessage string `protobuf:"bytes,2,opt,name=current_message,json=currentMessage,proto3" json:"current_message,omitempty"`
+	// urut dari yang paling lama
+	Revisions     []*MessageRevision `protobuf:"bytes,3,rep,name=revisions,proto3" json:"revisions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddMemberRequest) Reset() {
-	*x = AddMemberRequest{}
+func (x *GetMessageRevisionsResponse) Reset() {
+	*x = GetMessageRevisionsResponse{}
 	mi := &file_proto_chat_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddMemberRequest) String() string {
+func (x *GetMessageRevisionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddMemberRequest) ProtoMessage() {}
+func (*GetMessageRevisionsResponse) ProtoMessage() {}
 
-func (x *AddMemberRequest) ProtoReflect() protoreflect.Message {
+func (x *GetMessageRevisionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_chat_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -591,47 +723,54 @@ func (x *AddMemberRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddMemberRequest.ProtoReflect.Descriptor instead.
-func (*AddMemberRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetMessageRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*GetMessageRevisionsResponse) Descriptor() ([]byte, []int) {
 	return file_proto_chat_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *AddMemberRequest) GetGroupId() uint64 {
+func (x *GetMessageRevisionsResponse) GetChat() *ChatStreamingResponse {
 	if x != nil {
-		return x.GroupId
+		return x.Chat
 	}
-	return 0
+	return nil
 }
 
-func (x *AddMemberRequest) GetListUserId() []*ListUserId {
+func (x *GetMessageRevisionsResponse) GetCurrentMessage() string {
 	if x != nil {
-		return x.ListUserId
+		return x.CurrentMessage
+	}
+	return ""
+}
+
+func (x *GetMessageRevisionsResponse) GetRevisions() []*MessageRevision {
+	if x != nil {
+		return x.Revisions
 	}
 	return nil
 }
 
-type RemoveMemberRequest struct {
+type PurgeChatRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	GroupId       uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	ListMemberId  []*ListUserId          `protobuf:"bytes,2,rep,name=list_member_id,json=listMemberId,proto3" json:"list_member_id,omitempty"`
+	ChatId        uint64                 `protobuf:"varint,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveMemberRequest) Reset() {
-	*x = RemoveMemberRequest{}
+func (x *PurgeChatRequest) Reset() {
+	*x = PurgeChatRequest{}
 	mi := &file_proto_chat_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveMemberRequest) String() string {
+func (x *PurgeChatRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveMemberRequest) ProtoMessage() {}
+func (*PurgeChatRequest) ProtoMessage() {}
 
-func (x *RemoveMemberRequest) ProtoReflect() protoreflect.Message {
+func (x *PurgeChatRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_chat_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -643,46 +782,48 @@ func (x *RemoveMemberRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveMemberRequest.ProtoReflect.Descriptor instead.
-func (*RemoveMemberRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PurgeChatRequest.ProtoReflect.Descriptor instead.
+func (*PurgeChatRequest) Descriptor() ([]byte, []int) {
 	return file_proto_chat_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *RemoveMemberRequest) GetGroupId() uint64 {
+func (x *PurgeChatRequest) GetGroupId() uint64 {
 	if x != nil {
 		return x.GroupId
 	}
 	return 0
 }
 
-func (x *RemoveMemberRequest) GetListMemberId() []*ListUserId {
+func (x *PurgeChatRequest) GetChatId() uint64 {
 	if x != nil {
-		return x.ListMemberId
+		return x.ChatId
 	}
-	return nil
+	return 0
 }
 
-type ListUserId struct {
+type UpdateChatRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GroupId       uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	ChatId        uint64                 `protobuf:"varint,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUserId) Reset() {
-	*x = ListUserId{}
+func (x *UpdateChatRequest) Reset() {
+	*x = UpdateChatRequest{}
 	mi := &file_proto_chat_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUserId) String() string {
+func (x *UpdateChatRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUserId) ProtoMessage() {}
+func (*UpdateChatRequest) ProtoMessage() {}
 
-func (x *ListUserId) ProtoReflect() protoreflect.Message {
+func (x *UpdateChatRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_chat_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -694,39 +835,57 @@ func (x *ListUserId) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUserId.ProtoReflect.Descriptor instead.
-func (*ListUserId) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateChatRequest.ProtoReflect.Descriptor instead.
+func (*UpdateChatRequest) Descriptor() ([]byte, []int) {
 	return file_proto_chat_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ListUserId) GetUserId() uint64 {
+func (x *UpdateChatRequest) GetGroupId() uint64 {
 	if x != nil {
-		return x.UserId
+		return x.GroupId
 	}
 	return 0
 }
 
-type ExitGroupRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GroupId       uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+func (x *UpdateChatRequest) GetChatId() uint64 {
+	if x != nil {
+		return x.ChatId
+	}
+	return 0
+}
+
+func (x *UpdateChatRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// write group & member
+type CreateGroupRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Desc  string                 `protobuf:"bytes,2,opt,name=desc,proto3" json:"desc,omitempty"`
+	// default private
+	Visibility    string `protobuf:"bytes,3,opt,name=visibility,proto3" json:"visibility,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExitGroupRequest) Reset() {
-	*x = ExitGroupRequest{}
+func (x *CreateGroupRequest) Reset() {
+	*x = CreateGroupRequest{}
 	mi := &file_proto_chat_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExitGroupRequest) String() string {
+func (x *CreateGroupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExitGroupRequest) ProtoMessage() {}
+func (*CreateGroupRequest) ProtoMessage() {}
 
-func (x *ExitGroupRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_chat_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -738,40 +897,3821 @@ func (x *ExitGroupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExitGroupRequest.ProtoReflect.Descriptor instead.
-func (*ExitGroupRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateGroupRequest.ProtoReflect.Descriptor instead.
+func (*CreateGroupRequest) Descriptor() ([]byte, []int) {
 	return file_proto_chat_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ExitGroupRequest) GetGroupId() uint64 {
+func (x *CreateGroupRequest) GetName() string {
 	if x != nil {
-		return x.GroupId
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-type StatusResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
+func (x *CreateGroupRequest) GetDesc() string {
+	if x != nil {
+		return x.Desc
+	}
+	return ""
+}
 
-func (x *StatusResponse) Reset() {
-	*x = StatusResponse{}
+func (x *CreateGroupRequest) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+type DeleteGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGroupRequest) Reset() {
+	*x = DeleteGroupRequest{}
 	mi := &file_proto_chat_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StatusResponse) String() string {
+func (x *DeleteGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGroupRequest) ProtoMessage() {}
+
+func (x *DeleteGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGroupRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGroupRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteGroupRequest) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+type UpdateGroupRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	GroupId uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Name    string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Desc    string                 `protobuf:"bytes,3,opt,name=desc,proto3" json:"desc,omitempty"`
+	// disappearing message dalam detik untuk chat berikutnya, 0 mematikan,
+	// tidak diisi berarti tidak berubah
+	MessageTtlSeconds *int64 `protobuf:"varint,4,opt,name=message_ttl_seconds,json=messageTtlSeconds,proto3,oneof" json:"message_ttl_seconds,omitempty"`
+	// tidak diisi berarti tidak berubah
+	Visibility    *string `protobuf:"bytes,5,opt,name=visibility,proto3,oneof" json:"visibility,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGroupRequest) Reset() {
+	*x = UpdateGroupRequest{}
+	mi := &file_proto_chat_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupRequest) ProtoMessage() {}
+
+func (x *UpdateGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGroupRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateGroupRequest) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *UpdateGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateGroupRequest) GetDesc() string {
+	if x != nil {
+		return x.Desc
+	}
+	return ""
+}
+
+func (x *UpdateGroupRequest) GetMessageTtlSeconds() int64 {
+	if x != nil && x.MessageTtlSeconds != nil {
+		return *x.MessageTtlSeconds
+	}
+	return 0
+}
+
+func (x *UpdateGroupRequest) GetVisibility() string {
+	if x != nil && x.Visibility != nil {
+		return *x.Visibility
+	}
+	return ""
+}
+
+type UpdateRoleUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemberId      uint64                 `protobuf:"varint,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	GroupId       uint64                 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRoleUserRequest) Reset() {
+	*x = UpdateRoleUserRequest{}
+	mi := &file_proto_chat_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRoleUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoleUserRequest) ProtoMessage() {}
+
+func (x *UpdateRoleUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoleUserRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRoleUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdateRoleUserRequest) GetMemberId() uint64 {
+	if x != nil {
+		return x.MemberId
+	}
+	return 0
+}
+
+func (x *UpdateRoleUserRequest) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *UpdateRoleUserRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type AddMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	ListUserId    []*ListUserId          `protobuf:"bytes,2,rep,name=list_user_id,json=listUserId,proto3" json:"list_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddMemberRequest) Reset() {
+	*x = AddMemberRequest{}
+	mi := &file_proto_chat_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMemberRequest) ProtoMessage() {}
+
+func (x *AddMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMemberRequest.ProtoReflect.Descriptor instead.
+func (*AddMemberRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AddMemberRequest) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *AddMemberRequest) GetListUserId() []*ListUserId {
+	if x != nil {
+		return x.ListUserId
+	}
+	return nil
+}
+
+type RemoveMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	ListMemberId  []*ListUserId          `protobuf:"bytes,2,rep,name=list_member_id,json=listMemberId,proto3" json:"list_member_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveMemberRequest) Reset() {
+	*x = RemoveMemberRequest{}
+	mi := &file_proto_chat_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMemberRequest) ProtoMessage() {}
+
+func (x *RemoveMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveMemberRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RemoveMemberRequest) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *RemoveMemberRequest) GetListMemberId() []*ListUserId {
+	if x != nil {
+		return x.ListMemberId
+	}
+	return nil
+}
+
+type ListUserId struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserId) Reset() {
+	*x = ListUserId{}
+	mi := &file_proto_chat_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserId) ProtoMessage() {}
+
+func (x *ListUserId) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserId.ProtoReflect.Descriptor instead.
+func (*ListUserId) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListUserId) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ExitGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExitGroupRequest) Reset() {
+	*x = ExitGroupRequest{}
+	mi := &file_proto_chat_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExitGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExitGroupRequest) ProtoMessage() {}
+
+func (x *ExitGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExitGroupRequest.ProtoReflect.Descriptor instead.
+func (*ExitGroupRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ExitGroupRequest) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+type StatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        bool                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusResponse) Reset() {
+	*x = StatusResponse{}
+	mi := &file_proto_chat_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusResponse) ProtoMessage() {}
+
+func (x *StatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusResponse.ProtoReflect.Descriptor instead.
+func (*StatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *StatusResponse) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+// chat stream
+type ChatStreamingRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	GroupId uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// isi dengan id root thread untuk hanya menerima event thread tersebut
+	ThreadId      uint64 `protobuf:"varint,2,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatStreamingRequest) Reset() {
+	*x = ChatStreamingRequest{}
+	mi := &file_proto_chat_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatStreamingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatStreamingRequest) ProtoMessage() {}
+
+func (x *ChatStreamingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatStreamingRequest.ProtoReflect.Descriptor instead.
+func (*ChatStreamingRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ChatStreamingRequest) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *ChatStreamingRequest) GetThreadId() uint64 {
+	if x != nil {
+		return x.ThreadId
+	}
+	return 0
+}
+
+type ChatStreamingResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Member       uint64                 `protobuf:"varint,1,opt,name=member,proto3" json:"member,omitempty"`
+	Username     string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Message      string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Timestamp    string                 `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Action       Action                 `protobuf:"varint,5,opt,name=action,proto3,enum=pb.Action" json:"action,omitempty"`
+	ReadStatus   []*AnyUserStatus       `protobuf:"bytes,6,rep,name=readStatus,proto3" json:"readStatus,omitempty"`
+	ChatId       uint64                 `protobuf:"varint,7,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	GroupId      uint64                 `protobuf:"varint,8,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Attachments  []*Attachment          `protobuf:"bytes,9,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	Reactions    []*ReactionCount       `protobuf:"bytes,10,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	ReplyTo      *ReplyPreview          `protobuf:"bytes,11,opt,name=reply_to,json=replyTo,proto3" json:"reply_to,omitempty"`
+	ThreadRootId uint64                 `protobuf:"varint,12,opt,name=thread_root_id,json=threadRootId,proto3" json:"thread_root_id,omitempty"`
+	// khusus root thread
+	ThreadReplyCount int32  `protobuf:"varint,13,opt,name=thread_reply_count,json=threadReplyCount,proto3" json:"thread_reply_count,omitempty"`
+	LastReplyAt      string `protobuf:"bytes,14,opt,name=last_reply_at,json=lastReplyAt,proto3" json:"last_reply_at,omitempty"`
+	// member id yang di-mention
+	Mentions []uint64 `protobuf:"varint,15,rep,packed,name=mentions,proto3" json:"mentions,omitempty"`
+	Pinned   bool     `protobuf:"varint,16,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	// member id yang melakukan pin
+	PinnedBy uint64 `protobuf:"varint,17,opt,name=pinned_by,json=pinnedBy,proto3" json:"pinned_by,omitempty"`
+	PinnedAt string `protobuf:"bytes,18,opt,name=pinned_at,json=pinnedAt,proto3" json:"pinned_at,omitempty"`
+	Typing   bool   `protobuf:"varint,19,opt,name=typing,proto3" json:"typing,omitempty"`
+	// tombstone: message, attachments, reactions dan mentions dikosongkan
+	Deleted   bool   `protobuf:"varint,20,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	DeletedBy uint64 `protobuf:"varint,21,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	DeletedAt string `protobuf:"bytes,22,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// terisi kalau chat pernah diedit
+	EditedAt      string       `protobuf:"bytes,23,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
+	ForwardedFrom *ForwardInfo `protobuf:"bytes,24,opt,name=forwarded_from,json=forwardedFrom,proto3" json:"forwarded_from,omitempty"`
+	// terisi kalau grup memakai disappearing message
+	ExpiresAt string `protobuf:"bytes,25,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// hanya untuk action ProfileUpdate
+	Profile *UserProfile `protobuf:"bytes,26,opt,name=profile,proto3" json:"profile,omitempty"`
+	// pengirim diblokir oleh user penerima, client bisa menyembunyikan isinya
+	SenderBlocked bool `protobuf:"varint,27,opt,name=sender_blocked,json=senderBlocked,proto3" json:"sender_blocked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatStreamingResponse) Reset() {
+	*x = ChatStreamingResponse{}
+	mi := &file_proto_chat_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatStreamingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatStreamingResponse) ProtoMessage() {}
+
+func (x *ChatStreamingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatStreamingResponse.ProtoReflect.Descriptor instead.
+func (*ChatStreamingResponse) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ChatStreamingResponse) GetMember() uint64 {
+	if x != nil {
+		return x.Member
+	}
+	return 0
+}
+
+func (x *ChatStreamingResponse) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ChatStreamingResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ChatStreamingResponse) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *ChatStreamingResponse) GetAction() Action {
+	if x != nil {
+		return x.Action
+	}
+	return Action_Create
+}
+
+func (x *ChatStreamingResponse) GetReadStatus() []*AnyUserStatus {
+	if x != nil {
+		return x.ReadStatus
+	}
+	return nil
+}
+
+func (x *ChatStreamingResponse) GetChatId() uint64 {
+	if x != nil {
+		return x.ChatId
+	}
+	return 0
+}
+
+func (x *ChatStreamingResponse) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *ChatStreamingResponse) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *ChatStreamingResponse) GetReactions() []*ReactionCount {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *ChatStreamingResponse) GetReplyTo() *ReplyPreview {
+	if x != nil {
+		return x.ReplyTo
+	}
+	return nil
+}
+
+func (x *ChatStreamingResponse) GetThreadRootId() uint64 {
+	if x != nil {
+		return x.ThreadRootId
+	}
+	return 0
+}
+
+func (x *ChatStreamingResponse) GetThreadReplyCount() int32 {
+	if x != nil {
+		return x.ThreadReplyCount
+	}
+	return 0
+}
+
+func (x *ChatStreamingResponse) GetLastReplyAt() string {
+	if x != nil {
+		return x.LastReplyAt
+	}
+	return ""
+}
+
+func (x *ChatStreamingResponse) GetMentions() []uint64 {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+func (x *ChatStreamingResponse) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *ChatStreamingResponse) GetPinnedBy() uint64 {
+	if x != nil {
+		return x.PinnedBy
+	}
+	return 0
+}
+
+func (x *ChatStreamingResponse) GetPinnedAt() string {
+	if x != nil {
+		return x.PinnedAt
+	}
+	return ""
+}
+
+func (x *ChatStreamingResponse) GetTyping() bool {
+	if x != nil {
+		return x.Typing
+	}
+	return false
+}
+
+func (x *ChatStreamingResponse) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *ChatStreamingResponse) GetDeletedBy() uint64 {
+	if x != nil {
+		return x.DeletedBy
+	}
+	return 0
+}
+
+func (x *ChatStreamingResponse) GetDeletedAt() string {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return ""
+}
+
+func (x *ChatStreamingResponse) GetEditedAt() string {
+	if x != nil {
+		return x.EditedAt
+	}
+	return ""
+}
+
+func (x *ChatStreamingResponse) GetForwardedFrom() *ForwardInfo {
+	if x != nil {
+		return x.ForwardedFrom
+	}
+	return nil
+}
+
+func (x *ChatStreamingResponse) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *ChatStreamingResponse) GetProfile() *UserProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *ChatStreamingResponse) GetSenderBlocked() bool {
+	if x != nil {
+		return x.SenderBlocked
+	}
+	return false
+}
+
+// atribusi chat asli untuk chat hasil forward
+type ForwardInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChatId        uint64                 `protobuf:"varint,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	GroupId       uint64                 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	GroupName     string                 `protobuf:"bytes,3,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	UserId        uint64                 `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForwardInfo) Reset() {
+	*x = ForwardInfo{}
+	mi := &file_proto_chat_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForwardInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForwardInfo) ProtoMessage() {}
+
+func (x *ForwardInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForwardInfo.ProtoReflect.Descriptor instead.
+func (*ForwardInfo) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ForwardInfo) GetChatId() uint64 {
+	if x != nil {
+		return x.ChatId
+	}
+	return 0
+}
+
+func (x *ForwardInfo) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *ForwardInfo) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *ForwardInfo) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ForwardInfo) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type ReplyPreview struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChatId        uint64                 `protobuf:"varint,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	Member        uint64                 `protobuf:"varint,2,opt,name=member,proto3" json:"member,omitempty"`
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Snippet       string                 `protobuf:"bytes,4,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	Deleted       bool                   `protobuf:"varint,5,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplyPreview) Reset() {
+	*x = ReplyPreview{}
+	mi := &file_proto_chat_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplyPreview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplyPreview) ProtoMessage() {}
+
+func (x *ReplyPreview) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplyPreview.ProtoReflect.Descriptor instead.
+func (*ReplyPreview) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ReplyPreview) GetChatId() uint64 {
+	if x != nil {
+		return x.ChatId
+	}
+	return 0
+}
+
+func (x *ReplyPreview) GetMember() uint64 {
+	if x != nil {
+		return x.Member
+	}
+	return 0
+}
+
+func (x *ReplyPreview) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ReplyPreview) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+func (x *ReplyPreview) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+type ReactionCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Emoji         string                 `protobuf:"bytes,1,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	MemberIds     []uint64               `protobuf:"varint,3,rep,packed,name=member_ids,json=memberIds,proto3" json:"member_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactionCount) Reset() {
+	*x = ReactionCount{}
+	mi := &file_proto_chat_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactionCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactionCount) ProtoMessage() {}
+
+func (x *ReactionCount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactionCount.ProtoReflect.Descriptor instead.
+func (*ReactionCount) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ReactionCount) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *ReactionCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ReactionCount) GetMemberIds() []uint64 {
+	if x != nil {
+		return x.MemberIds
+	}
+	return nil
+}
+
+// status user stream
+type StatusStreamingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusStreamingRequest) Reset() {
+	*x = StatusStreamingRequest{}
+	mi := &file_proto_chat_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusStreamingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusStreamingRequest) ProtoMessage() {}
+
+func (x *StatusStreamingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusStreamingRequest.ProtoReflect.Descriptor instead.
+func (*StatusStreamingRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *StatusStreamingRequest) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+type StatusStreamingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        uint64                 `protobuf:"varint,1,opt,name=member,proto3" json:"member,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Typing        bool                   `protobuf:"varint,4,opt,name=typing,proto3" json:"typing,omitempty"`
+	Event         StatusEvent            `protobuf:"varint,5,opt,name=event,proto3,enum=pb.StatusEvent" json:"event,omitempty"`
+	JoinRequest   *JoinRequest           `protobuf:"bytes,6,opt,name=join_request,json=joinRequest,proto3" json:"join_request,omitempty"`
+	Profile       *UserProfile           `protobuf:"bytes,7,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusStreamingResponse) Reset() {
+	*x = StatusStreamingResponse{}
+	mi := &file_proto_chat_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusStreamingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusStreamingResponse) ProtoMessage() {}
+
+func (x *StatusStreamingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusStreamingResponse.ProtoReflect.Descriptor instead.
+func (*StatusStreamingResponse) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *StatusStreamingResponse) GetMember() uint64 {
+	if x != nil {
+		return x.Member
+	}
+	return 0
+}
+
+func (x *StatusStreamingResponse) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *StatusStreamingResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *StatusStreamingResponse) GetTyping() bool {
+	if x != nil {
+		return x.Typing
+	}
+	return false
+}
+
+func (x *StatusStreamingResponse) GetEvent() StatusEvent {
+	if x != nil {
+		return x.Event
+	}
+	return StatusEvent_Presence
+}
+
+func (x *StatusStreamingResponse) GetJoinRequest() *JoinRequest {
+	if x != nil {
+		return x.JoinRequest
+	}
+	return nil
+}
+
+func (x *StatusStreamingResponse) GetProfile() *UserProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type SetTypingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Typing        bool                   `protobuf:"varint,2,opt,name=typing,proto3" json:"typing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTypingRequest) Reset() {
+	*x = SetTypingRequest{}
+	mi := &file_proto_chat_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTypingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTypingRequest) ProtoMessage() {}
+
+func (x *SetTypingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTypingRequest.ProtoReflect.Descriptor instead.
+func (*SetTypingRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SetTypingRequest) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *SetTypingRequest) GetTyping() bool {
+	if x != nil {
+		return x.Typing
+	}
+	return false
+}
+
+// GetGroup by user
+type GetListGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         []*GroupInfo           `protobuf:"bytes,1,rep,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetListGroupResponse) Reset() {
+	*x = GetListGroupResponse{}
+	mi := &file_proto_chat_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetListGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListGroupResponse) ProtoMessage() {}
+
+func (x *GetListGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListGroupResponse.ProtoReflect.Descriptor instead.
+func (*GetListGroupResponse) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetListGroupResponse) GetGroup() []*GroupInfo {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+type GroupInfo struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	LastMessage        string                 `protobuf:"bytes,3,opt,name=last_message,json=lastMessage,proto3" json:"last_message,omitempty"`
+	UnreadCount        int32                  `protobuf:"varint,4,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	UnreadMentionCount int32                  `protobuf:"varint,5,opt,name=unread_mention_count,json=unreadMentionCount,proto3" json:"unread_mention_count,omitempty"`
+	MessageTtlSeconds  int64                  `protobuf:"varint,6,opt,name=message_ttl_seconds,json=messageTtlSeconds,proto3" json:"message_ttl_seconds,omitempty"`
+	Visibility         string                 `protobuf:"bytes,7,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GroupInfo) Reset() {
+	*x = GroupInfo{}
+	mi := &file_proto_chat_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupInfo) ProtoMessage() {}
+
+func (x *GroupInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupInfo.ProtoReflect.Descriptor instead.
+func (*GroupInfo) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GroupInfo) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GroupInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GroupInfo) GetLastMessage() string {
+	if x != nil {
+		return x.LastMessage
+	}
+	return ""
+}
+
+func (x *GroupInfo) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+func (x *GroupInfo) GetUnreadMentionCount() int32 {
+	if x != nil {
+		return x.UnreadMentionCount
+	}
+	return 0
+}
+
+func (x *GroupInfo) GetMessageTtlSeconds() int64 {
+	if x != nil {
+		return x.MessageTtlSeconds
+	}
+	return 0
+}
+
+func (x *GroupInfo) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+// attachment
+type Attachment struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FileName    string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Size        int64                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	Sha256      string                 `protobuf:"bytes,5,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	// khusus gambar, terisi setelah diproses
+	Width    int32  `protobuf:"varint,6,opt,name=width,proto3" json:"width,omitempty"`
+	Height   int32  `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	Blurhash string `protobuf:"bytes,8,opt,name=blurhash,proto3" json:"blurhash,omitempty"`
+	// processing | ready | failed
+	Status        string       `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	Thumbnails    []*Thumbnail `protobuf:"bytes,10,rep,name=thumbnails,proto3" json:"thumbnails,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_proto_chat_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Attachment) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Attachment) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *Attachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Attachment) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Attachment) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *Attachment) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Attachment) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Attachment) GetBlurhash() string {
+	if x != nil {
+		return x.Blurhash
+	}
+	return ""
+}
+
+func (x *Attachment) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Attachment) GetThumbnails() []*Thumbnail {
+	if x != nil {
+		return x.Thumbnails
+	}
+	return nil
+}
+
+type Thumbnail struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// sisi terpanjang sesuai THUMBNAIL_SIZES
+	Size          int32 `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+	Width         int32 `protobuf:"varint,2,opt,name=width,proto3" json:"width,omitempty"`
+	Height        int32 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Thumbnail) Reset() {
+	*x = Thumbnail{}
+	mi := &file_proto_chat_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Thumbnail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Thumbnail) ProtoMessage() {}
+
+func (x *Thumbnail) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Thumbnail.ProtoReflect.Descriptor instead.
+func (*Thumbnail) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Thumbnail) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Thumbnail) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Thumbnail) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type AttachmentInfo struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	GroupId     uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	FileName    string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Size        int64                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	// hex sha256 dari seluruh isi file, dicek setelah chunk terakhir
+	Sha256        string `protobuf:"bytes,5,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachmentInfo) Reset() {
+	*x = AttachmentInfo{}
+	mi := &file_proto_chat_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachmentInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentInfo) ProtoMessage() {}
+
+func (x *AttachmentInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentInfo.ProtoReflect.Descriptor instead.
+func (*AttachmentInfo) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *AttachmentInfo) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *AttachmentInfo) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *AttachmentInfo) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *AttachmentInfo) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *AttachmentInfo) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+type UploadAttachmentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Data:
+	//
+	//	*UploadAttachmentRequest_Info
+	//	*UploadAttachmentRequest_Chunk
+	Data          isUploadAttachmentRequest_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentRequest) Reset() {
+	*x = UploadAttachmentRequest{}
+	mi := &file_proto_chat_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentRequest) ProtoMessage() {}
+
+func (x *UploadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *UploadAttachmentRequest) GetData() isUploadAttachmentRequest_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *UploadAttachmentRequest) GetInfo() *AttachmentInfo {
+	if x != nil {
+		if x, ok := x.Data.(*UploadAttachmentRequest_Info); ok {
+			return x.Info
+		}
+	}
+	return nil
+}
+
+func (x *UploadAttachmentRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Data.(*UploadAttachmentRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isUploadAttachmentRequest_Data interface {
+	isUploadAttachmentRequest_Data()
+}
+
+type UploadAttachmentRequest_Info struct {
+	Info *AttachmentInfo `protobuf:"bytes,1,opt,name=info,proto3,oneof"`
+}
+
+type UploadAttachmentRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadAttachmentRequest_Info) isUploadAttachmentRequest_Data() {}
+
+func (*UploadAttachmentRequest_Chunk) isUploadAttachmentRequest_Data() {}
+
+type UploadAttachmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachment    *Attachment            `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentResponse) Reset() {
+	*x = UploadAttachmentResponse{}
+	mi := &file_proto_chat_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentResponse) ProtoMessage() {}
+
+func (x *UploadAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UploadAttachmentResponse) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+type DownloadAttachmentRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	AttachmentId uint64                 `protobuf:"varint,1,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
+	// 0 untuk file asli, selain itu salah satu Thumbnail.size
+	ThumbnailSize int32 `protobuf:"varint,2,opt,name=thumbnail_size,json=thumbnailSize,proto3" json:"thumbnail_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadAttachmentRequest) Reset() {
+	*x = DownloadAttachmentRequest{}
+	mi := &file_proto_chat_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadAttachmentRequest) ProtoMessage() {}
+
+func (x *DownloadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*DownloadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DownloadAttachmentRequest) GetAttachmentId() uint64 {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return 0
+}
+
+func (x *DownloadAttachmentRequest) GetThumbnailSize() int32 {
+	if x != nil {
+		return x.ThumbnailSize
+	}
+	return 0
+}
+
+type DownloadAttachmentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Data:
+	//
+	//	*DownloadAttachmentResponse_Info
+	//	*DownloadAttachmentResponse_Chunk
+	Data          isDownloadAttachmentResponse_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadAttachmentResponse) Reset() {
+	*x = DownloadAttachmentResponse{}
+	mi := &file_proto_chat_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadAttachmentResponse) ProtoMessage() {}
+
+func (x *DownloadAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*DownloadAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DownloadAttachmentResponse) GetData() isDownloadAttachmentResponse_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *DownloadAttachmentResponse) GetInfo() *Attachment {
+	if x != nil {
+		if x, ok := x.Data.(*DownloadAttachmentResponse_Info); ok {
+			return x.Info
+		}
+	}
+	return nil
+}
+
+func (x *DownloadAttachmentResponse) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Data.(*DownloadAttachmentResponse_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isDownloadAttachmentResponse_Data interface {
+	isDownloadAttachmentResponse_Data()
+}
+
+type DownloadAttachmentResponse_Info struct {
+	Info *Attachment `protobuf:"bytes,1,opt,name=info,proto3,oneof"`
+}
+
+type DownloadAttachmentResponse_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*DownloadAttachmentResponse_Info) isDownloadAttachmentResponse_Data() {}
+
+func (*DownloadAttachmentResponse_Chunk) isDownloadAttachmentResponse_Data() {}
+
+// reaction
+type AddReactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	ChatId        uint64                 `protobuf:"varint,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	Emoji         string                 `protobuf:"bytes,3,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddReactionRequest) Reset() {
+	*x = AddReactionRequest{}
+	mi := &file_proto_chat_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddReactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddReactionRequest) ProtoMessage() {}
+
+func (x *AddReactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddReactionRequest.ProtoReflect.Descriptor instead.
+func (*AddReactionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *AddReactionRequest) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *AddReactionRequest) GetChatId() uint64 {
+	if x != nil {
+		return x.ChatId
+	}
+	return 0
+}
+
+func (x *AddReactionRequest) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+type RemoveReactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	ChatId        uint64                 `protobuf:"varint,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	Emoji         string                 `protobuf:"bytes,3,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveReactionRequest) Reset() {
+	*x = RemoveReactionRequest{}
+	mi := &file_proto_chat_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveReactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveReactionRequest) ProtoMessage() {}
+
+func (x *RemoveReactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveReactionRequest.ProtoReflect.Descriptor instead.
+func (*RemoveReactionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RemoveReactionRequest) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *RemoveReactionRequest) GetChatId() uint64 {
+	if x != nil {
+		return x.ChatId
+	}
+	return 0
+}
+
+func (x *RemoveReactionRequest) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+// thread
+type ListThreadRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	GroupId uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// root thread atau salah satu balasannya
+	ChatId        uint64 `protobuf:"varint,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListThreadRequest) Reset() {
+	*x = ListThreadRequest{}
+	mi := &file_proto_chat_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListThreadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListThreadRequest) ProtoMessage() {}
+
+func (x *ListThreadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListThreadRequest.ProtoReflect.Descriptor instead.
+func (*ListThreadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListThreadRequest) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *ListThreadRequest) GetChatId() uint64 {
+	if x != nil {
+		return x.ChatId
+	}
+	return 0
+}
+
+type ListThreadResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Root          *ChatStreamingResponse   `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	Replies       []*ChatStreamingResponse `protobuf:"bytes,2,rep,name=replies,proto3" json:"replies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListThreadResponse) Reset() {
+	*x = ListThreadResponse{}
+	mi := &file_proto_chat_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListThreadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListThreadResponse) ProtoMessage() {}
+
+func (x *ListThreadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListThreadResponse.ProtoReflect.Descriptor instead.
+func (*ListThreadResponse) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListThreadResponse) GetRoot() *ChatStreamingResponse {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+func (x *ListThreadResponse) GetReplies() []*ChatStreamingResponse {
+	if x != nil {
+		return x.Replies
+	}
+	return nil
+}
+
+// mention
+type ListMentionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0 untuk semua grup
+	GroupId    uint64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	UnreadOnly bool   `protobuf:"varint,2,opt,name=unread_only,json=unreadOnly,proto3" json:"unread_only,omitempty"`
+	// default 50
+	Limit         int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMentionsRequest) Reset() {
+	*x = ListMentionsRequest{}
+	mi := &file_proto_chat_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMentionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMentionsRequest) ProtoMessage() {}
+
+func (x *ListMentionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMentionsRequest.ProtoReflect.Descriptor instead.
+func (*ListMentionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListMentionsRequest) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *ListMentionsRequest) GetUnreadOnly() bool {
+	if x != nil {
+		return x.UnreadOnly
+	}
+	return false
+}
+
+func (x *ListMentionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type MentionInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	GroupId       uint64                 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	IsRead        bool                   `protobuf:"varint,3,opt,name=is_read,json=isRead,proto3" json:"is_read,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Chat          *ChatStreamingResponse `protobuf:"bytes,5,opt,name=chat,proto3" json:"chat,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MentionInfo) Reset() {
+	*x = MentionInfo{}
+	mi := &file_proto_chat_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MentionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MentionInfo) ProtoMessage() {}
+
+func (x *MentionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MentionInfo.ProtoReflect.Descriptor instead.
+func (*MentionInfo) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *MentionInfo) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MentionInfo) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *MentionInfo) GetIsRead() bool {
+	if x != nil {
+		return x.IsRead
+	}
+	return false
+}
+
+func (x *MentionInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *MentionInfo) GetChat() *ChatStreamingResponse {
+	if x != nil {
+		return x.Chat
+	}
+	return nil
+}
+
+type ListMentionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mentions      []*MentionInfo         `protobuf:"bytes,1,rep,name=mentions,proto3" json:"mentions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMentionsResponse) Reset() {
+	*x = ListMentionsResponse{}
+	mi := &file_proto_chat_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMentionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMentionsResponse) ProtoMessage() {}
+
+func (x *ListMentionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMentionsResponse.ProtoReflect.Descriptor instead.
+func (*ListMentionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListMentionsResponse) GetMentions() []*MentionInfo {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+// pin
+type PinMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	ChatId        uint64                 `protobuf:"varint,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PinMessageRequest) Reset() {
+	*x = PinMessageRequest{}
+	mi := &file_proto_chat_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinMessageRequest) ProtoMessage() {}
+
+func (x *PinMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinMessageRequest.ProtoReflect.Descriptor instead.
+func (*PinMessageRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *PinMessageRequest) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *PinMessageRequest) GetChatId() uint64 {
+	if x != nil {
+		return x.ChatId
+	}
+	return 0
+}
+
+type UnpinMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	ChatId        uint64                 `protobuf:"varint,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnpinMessageRequest) Reset() {
+	*x = UnpinMessageRequest{}
+	mi := &file_proto_chat_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpinMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpinMessageRequest) ProtoMessage() {}
+
+func (x *UnpinMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpinMessageRequest.ProtoReflect.Descriptor instead.
+func (*UnpinMessageRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *UnpinMessageRequest) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *UnpinMessageRequest) GetChatId() uint64 {
+	if x != nil {
+		return x.ChatId
+	}
+	return 0
+}
+
+type ListPinnedMessagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPinnedMessagesRequest) Reset() {
+	*x = ListPinnedMessagesRequest{}
+	mi := &file_proto_chat_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPinnedMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPinnedMessagesRequest) ProtoMessage() {}
+
+func (x *ListPinnedMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPinnedMessagesRequest.ProtoReflect.Descriptor instead.
+func (*ListPinnedMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListPinnedMessagesRequest) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+type ListPinnedMessagesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// pin terbaru lebih dulu
+	Chats         []*ChatStreamingResponse `protobuf:"bytes,1,rep,name=chats,proto3" json:"chats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPinnedMessagesResponse) Reset() {
+	*x = ListPinnedMessagesResponse{}
+	mi := &file_proto_chat_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPinnedMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPinnedMessagesResponse) ProtoMessage() {}
+
+func (x *ListPinnedMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPinnedMessagesResponse.ProtoReflect.Descriptor instead.
+func (*ListPinnedMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListPinnedMessagesResponse) GetChats() []*ChatStreamingResponse {
+	if x != nil {
+		return x.Chats
+	}
+	return nil
+}
+
+// search
+type SearchMessagesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Query string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// 0 untuk semua grup user
+	GroupId uint64 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// user id pengirim
+	AuthorId uint64 `protobuf:"varint,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	// RFC3339, from inklusif dan to eksklusif
+	From          string `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	To            string `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	HasAttachment *bool  `protobuf:"varint,6,opt,name=has_attachment,json=hasAttachment,proto3,oneof" json:"has_attachment,omitempty"`
+	// default 20
+	Limit         int32 `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32 `protobuf:"varint,8,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchMessagesRequest) Reset() {
+	*x = SearchMessagesRequest{}
+	mi := &file_proto_chat_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMessagesRequest) ProtoMessage() {}
+
+func (x *SearchMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMessagesRequest.ProtoReflect.Descriptor instead.
+func (*SearchMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SearchMessagesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchMessagesRequest) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *SearchMessagesRequest) GetAuthorId() uint64 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *SearchMessagesRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *SearchMessagesRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *SearchMessagesRequest) GetHasAttachment() bool {
+	if x != nil && x.HasAttachment != nil {
+		return *x.HasAttachment
+	}
+	return false
+}
+
+func (x *SearchMessagesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchMessagesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// posisi kata yang cocok di snippet, dalam rune [start, end)
+type Highlight struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         int32                  `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End           int32                  `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Highlight) Reset() {
+	*x = Highlight{}
+	mi := &file_proto_chat_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Highlight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Highlight) ProtoMessage() {}
+
+func (x *Highlight) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Highlight.ProtoReflect.Descriptor instead.
+func (*Highlight) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *Highlight) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *Highlight) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+type SearchHit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chat          *ChatStreamingResponse `protobuf:"bytes,1,opt,name=chat,proto3" json:"chat,omitempty"`
+	Snippet       string                 `protobuf:"bytes,2,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	Highlights    []*Highlight           `protobuf:"bytes,3,rep,name=highlights,proto3" json:"highlights,omitempty"`
+	Score         float64                `protobuf:"fixed64,4,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchHit) Reset() {
+	*x = SearchHit{}
+	mi := &file_proto_chat_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchHit) ProtoMessage() {}
+
+func (x *SearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchHit.ProtoReflect.Descriptor instead.
+func (*SearchHit) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SearchHit) GetChat() *ChatStreamingResponse {
+	if x != nil {
+		return x.Chat
+	}
+	return nil
+}
+
+func (x *SearchHit) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+func (x *SearchHit) GetHighlights() []*Highlight {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+func (x *SearchHit) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type SearchMessagesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Hits  []*SearchHit           `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`
+	// jumlah semua hasil sebelum limit/offset
+	Total         int32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchMessagesResponse) Reset() {
+	*x = SearchMessagesResponse{}
+	mi := &file_proto_chat_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMessagesResponse) ProtoMessage() {}
+
+func (x *SearchMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMessagesResponse.ProtoReflect.Descriptor instead.
+func (*SearchMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SearchMessagesResponse) GetHits() []*SearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+func (x *SearchMessagesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// scheduled message
+type ScheduleMessageRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	GroupId uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// RFC3339, maksimal 365 hari ke depan
+	SendAt        string `protobuf:"bytes,3,opt,name=send_at,json=sendAt,proto3" json:"send_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleMessageRequest) Reset() {
+	*x = ScheduleMessageRequest{}
+	mi := &file_proto_chat_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleMessageRequest) ProtoMessage() {}
+
+func (x *ScheduleMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleMessageRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleMessageRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ScheduleMessageRequest) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *ScheduleMessageRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ScheduleMessageRequest) GetSendAt() string {
+	if x != nil {
+		return x.SendAt
+	}
+	return ""
+}
+
+type ScheduledMessage struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	GroupId uint64                 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Message string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	SendAt  string                 `protobuf:"bytes,4,opt,name=send_at,json=sendAt,proto3" json:"send_at,omitempty"`
+	// pending | sending | sent | failed | canceled
+	Status string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	// alasan kalau failed
+	Error         string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt     string `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduledMessage) Reset() {
+	*x = ScheduledMessage{}
+	mi := &file_proto_chat_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduledMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledMessage) ProtoMessage() {}
+
+func (x *ScheduledMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledMessage.ProtoReflect.Descriptor instead.
+func (*ScheduledMessage) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ScheduledMessage) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ScheduledMessage) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *ScheduledMessage) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ScheduledMessage) GetSendAt() string {
+	if x != nil {
+		return x.SendAt
+	}
+	return ""
+}
+
+func (x *ScheduledMessage) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ScheduledMessage) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ScheduledMessage) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ScheduleMessageResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ScheduledMessage *ScheduledMessage      `protobuf:"bytes,1,opt,name=scheduled_message,json=scheduledMessage,proto3" json:"scheduled_message,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ScheduleMessageResponse) Reset() {
+	*x = ScheduleMessageResponse{}
+	mi := &file_proto_chat_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleMessageResponse) ProtoMessage() {}
+
+func (x *ScheduleMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleMessageResponse.ProtoReflect.Descriptor instead.
+func (*ScheduleMessageResponse) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ScheduleMessageResponse) GetScheduledMessage() *ScheduledMessage {
+	if x != nil {
+		return x.ScheduledMessage
+	}
+	return nil
+}
+
+type ListScheduledMessagesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0 untuk semua grup
+	GroupId       uint64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduledMessagesRequest) Reset() {
+	*x = ListScheduledMessagesRequest{}
+	mi := &file_proto_chat_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduledMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledMessagesRequest) ProtoMessage() {}
+
+func (x *ListScheduledMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledMessagesRequest.ProtoReflect.Descriptor instead.
+func (*ListScheduledMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListScheduledMessagesRequest) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+type ListScheduledMessagesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// yang masih pending atau gagal, urut berdasarkan send_at
+	ScheduledMessages []*ScheduledMessage `protobuf:"bytes,1,rep,name=scheduled_messages,json=scheduledMessages,proto3" json:"scheduled_messages,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListScheduledMessagesResponse) Reset() {
+	*x = ListScheduledMessagesResponse{}
+	mi := &file_proto_chat_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduledMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledMessagesResponse) ProtoMessage() {}
+
+func (x *ListScheduledMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledMessagesResponse.ProtoReflect.Descriptor instead.
+func (*ListScheduledMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListScheduledMessagesResponse) GetScheduledMessages() []*ScheduledMessage {
+	if x != nil {
+		return x.ScheduledMessages
+	}
+	return nil
+}
+
+type CancelScheduledMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelScheduledMessageRequest) Reset() {
+	*x = CancelScheduledMessageRequest{}
+	mi := &file_proto_chat_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScheduledMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledMessageRequest) ProtoMessage() {}
+
+func (x *CancelScheduledMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledMessageRequest.ProtoReflect.Descriptor instead.
+func (*CancelScheduledMessageRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *CancelScheduledMessageRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// invite
+type CreateInviteRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	GroupId uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// role member yang bergabung, default member
+	Role string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	// 0 berarti tanpa batas
+	MaxUses int32 `protobuf:"varint,3,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	// 0 berarti tidak kedaluwarsa, maksimal 365 hari
+	ExpiresInSeconds int64 `protobuf:"varint,4,opt,name=expires_in_seconds,json=expiresInSeconds,proto3" json:"expires_in_seconds,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateInviteRequest) Reset() {
+	*x = CreateInviteRequest{}
+	mi := &file_proto_chat_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInviteRequest) ProtoMessage() {}
+
+func (x *CreateInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInviteRequest.ProtoReflect.Descriptor instead.
+func (*CreateInviteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *CreateInviteRequest) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *CreateInviteRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *CreateInviteRequest) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *CreateInviteRequest) GetExpiresInSeconds() int64 {
+	if x != nil {
+		return x.ExpiresInSeconds
+	}
+	return 0
+}
+
+type Invite struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	GroupId uint64                 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Role    string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	MaxUses int32                  `protobuf:"varint,4,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	Uses    int32                  `protobuf:"varint,5,opt,name=uses,proto3" json:"uses,omitempty"`
+	// kosong kalau tidak kedaluwarsa
+	ExpiresAt string `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// member id admin pembuat
+	CreatedBy     uint64 `protobuf:"varint,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     string `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Invite) Reset() {
+	*x = Invite{}
+	mi := &file_proto_chat_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invite) ProtoMessage() {}
+
+func (x *Invite) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invite.ProtoReflect.Descriptor instead.
+func (*Invite) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *Invite) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Invite) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *Invite) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *Invite) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *Invite) GetUses() int32 {
+	if x != nil {
+		return x.Uses
+	}
+	return 0
+}
+
+func (x *Invite) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *Invite) GetCreatedBy() uint64 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
+}
+
+func (x *Invite) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type CreateInviteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invite        *Invite                `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInviteResponse) Reset() {
+	*x = CreateInviteResponse{}
+	mi := &file_proto_chat_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInviteResponse) ProtoMessage() {}
+
+func (x *CreateInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInviteResponse.ProtoReflect.Descriptor instead.
+func (*CreateInviteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CreateInviteResponse) GetInvite() *Invite {
+	if x != nil {
+		return x.Invite
+	}
+	return nil
+}
+
+type ListInvitesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvitesRequest) Reset() {
+	*x = ListInvitesRequest{}
+	mi := &file_proto_chat_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitesRequest) ProtoMessage() {}
+
+func (x *ListInvitesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitesRequest.ProtoReflect.Descriptor instead.
+func (*ListInvitesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListInvitesRequest) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+type ListInvitesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// termasuk yang sudah kedaluwarsa atau habis dipakai
+	Invites       []*Invite `protobuf:"bytes,1,rep,name=invites,proto3" json:"invites,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvitesResponse) Reset() {
+	*x = ListInvitesResponse{}
+	mi := &file_proto_chat_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitesResponse) ProtoMessage() {}
+
+func (x *ListInvitesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitesResponse.ProtoReflect.Descriptor instead.
+func (*ListInvitesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListInvitesResponse) GetInvites() []*Invite {
+	if x != nil {
+		return x.Invites
+	}
+	return nil
+}
+
+type RevokeInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInviteRequest) Reset() {
+	*x = RevokeInviteRequest{}
+	mi := &file_proto_chat_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInviteRequest) ProtoMessage() {}
+
+func (x *RevokeInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInviteRequest.ProtoReflect.Descriptor instead.
+func (*RevokeInviteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *RevokeInviteRequest) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *RevokeInviteRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type JoinByInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinByInviteRequest) Reset() {
+	*x = JoinByInviteRequest{}
+	mi := &file_proto_chat_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinByInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinByInviteRequest) ProtoMessage() {}
+
+func (x *JoinByInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinByInviteRequest.ProtoReflect.Descriptor instead.
+func (*JoinByInviteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *JoinByInviteRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type JoinByInviteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	MemberId      uint64                 `protobuf:"varint,2,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinByInviteResponse) Reset() {
+	*x = JoinByInviteResponse{}
+	mi := &file_proto_chat_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinByInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinByInviteResponse) ProtoMessage() {}
+
+func (x *JoinByInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinByInviteResponse.ProtoReflect.Descriptor instead.
+func (*JoinByInviteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *JoinByInviteResponse) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *JoinByInviteResponse) GetMemberId() uint64 {
+	if x != nil {
+		return x.MemberId
+	}
+	return 0
+}
+
+func (x *JoinByInviteResponse) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+// join request
+type JoinRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	GroupId  uint64                 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	UserId   uint64                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username string                 `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	Note     string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	// pending | approved | rejected
+	Status string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	// member id admin yang menyetujui / menolak
+	ReviewedBy    uint64 `protobuf:"varint,7,opt,name=reviewed_by,json=reviewedBy,proto3" json:"reviewed_by,omitempty"`
+	ReviewedAt    string `protobuf:"bytes,8,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`
+	CreatedAt     string `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinRequest) Reset() {
+	*x = JoinRequest{}
+	mi := &file_proto_chat_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinRequest) ProtoMessage() {}
+
+func (x *JoinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinRequest.ProtoReflect.Descriptor instead.
+func (*JoinRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *JoinRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *JoinRequest) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *JoinRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *JoinRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *JoinRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *JoinRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *JoinRequest) GetReviewedBy() uint64 {
+	if x != nil {
+		return x.ReviewedBy
+	}
+	return 0
+}
+
+func (x *JoinRequest) GetReviewedAt() string {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return ""
+}
+
+func (x *JoinRequest) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type RequestToJoinRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Note          string                 `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestToJoinRequest) Reset() {
+	*x = RequestToJoinRequest{}
+	mi := &file_proto_chat_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestToJoinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestToJoinRequest) ProtoMessage() {}
+
+func (x *RequestToJoinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestToJoinRequest.ProtoReflect.Descriptor instead.
+func (*RequestToJoinRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *RequestToJoinRequest) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *RequestToJoinRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type RequestToJoinResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JoinRequest   *JoinRequest           `protobuf:"bytes,1,opt,name=join_request,json=joinRequest,proto3" json:"join_request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestToJoinResponse) Reset() {
+	*x = RequestToJoinResponse{}
+	mi := &file_proto_chat_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestToJoinResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestToJoinResponse) ProtoMessage() {}
+
+func (x *RequestToJoinResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestToJoinResponse.ProtoReflect.Descriptor instead.
+func (*RequestToJoinResponse) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *RequestToJoinResponse) GetJoinRequest() *JoinRequest {
+	if x != nil {
+		return x.JoinRequest
+	}
+	return nil
+}
+
+type ListJoinRequestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJoinRequestsRequest) Reset() {
+	*x = ListJoinRequestsRequest{}
+	mi := &file_proto_chat_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJoinRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJoinRequestsRequest) ProtoMessage() {}
+
+func (x *ListJoinRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJoinRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListJoinRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ListJoinRequestsRequest) GetGroupId() uint64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+type ListJoinRequestsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// hanya yang masih pending, yang terlama dulu
+	JoinRequests  []*JoinRequest `protobuf:"bytes,1,rep,name=join_requests,json=joinRequests,proto3" json:"join_requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJoinRequestsResponse) Reset() {
+	*x = ListJoinRequestsResponse{}
+	mi := &file_proto_chat_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJoinRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJoinRequestsResponse) ProtoMessage() {}
+
+func (x *ListJoinRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJoinRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListJoinRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ListJoinRequestsResponse) GetJoinRequests() []*JoinRequest {
+	if x != nil {
+		return x.JoinRequests
+	}
+	return nil
+}
+
+type ApproveJoinRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	RequestId     uint64                 `protobuf:"varint,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveJoinRequestRequest) Reset() {
+	*x = ApproveJoinRequestRequest{}
+	mi := &file_proto_chat_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveJoinRequestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatusResponse) ProtoMessage() {}
+func (*ApproveJoinRequestRequest) ProtoMessage() {}
 
-func (x *StatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_chat_proto_msgTypes[13]
+func (x *ApproveJoinRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -782,41 +4722,48 @@ func (x *StatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatusResponse.ProtoReflect.Descriptor instead.
-func (*StatusResponse) Descriptor() ([]byte, []int) {
-	return file_proto_chat_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ApproveJoinRequestRequest.ProtoReflect.Descriptor instead.
+func (*ApproveJoinRequestRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *StatusResponse) GetStatus() bool {
+func (x *ApproveJoinRequestRequest) GetGroupId() uint64 {
 	if x != nil {
-		return x.Status
+		return x.GroupId
 	}
-	return false
+	return 0
 }
 
-// chat stream
-type ChatStreamingRequest struct {
+func (x *ApproveJoinRequestRequest) GetRequestId() uint64 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+type RejectJoinRequestRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	GroupId       uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	RequestId     uint64                 `protobuf:"varint,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChatStreamingRequest) Reset() {
-	*x = ChatStreamingRequest{}
-	mi := &file_proto_chat_proto_msgTypes[14]
+func (x *RejectJoinRequestRequest) Reset() {
+	*x = RejectJoinRequestRequest{}
+	mi := &file_proto_chat_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChatStreamingRequest) String() string {
+func (x *RejectJoinRequestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChatStreamingRequest) ProtoMessage() {}
+func (*RejectJoinRequestRequest) ProtoMessage() {}
 
-func (x *ChatStreamingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_chat_proto_msgTypes[14]
+func (x *RejectJoinRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -827,45 +4774,54 @@ func (x *ChatStreamingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChatStreamingRequest.ProtoReflect.Descriptor instead.
-func (*ChatStreamingRequest) Descriptor() ([]byte, []int) {
-	return file_proto_chat_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use RejectJoinRequestRequest.ProtoReflect.Descriptor instead.
+func (*RejectJoinRequestRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *ChatStreamingRequest) GetGroupId() uint64 {
+func (x *RejectJoinRequestRequest) GetGroupId() uint64 {
 	if x != nil {
 		return x.GroupId
 	}
 	return 0
 }
 
-type ChatStreamingResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Member        uint64                 `protobuf:"varint,1,opt,name=member,proto3" json:"member,omitempty"`
-	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	Timestamp     string                 `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Action        Action                 `protobuf:"varint,5,opt,name=action,proto3,enum=pb.Action" json:"action,omitempty"`
-	ReadStatus    []*AnyUserStatus       `protobuf:"bytes,6,rep,name=readStatus,proto3" json:"readStatus,omitempty"`
+func (x *RejectJoinRequestRequest) GetRequestId() uint64 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+// discovery
+type GroupPreview struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// public | private
+	Visibility    string `protobuf:"bytes,4,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	MemberCount   int32  `protobuf:"varint,5,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
+	IsMember      bool   `protobuf:"varint,6,opt,name=is_member,json=isMember,proto3" json:"is_member,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChatStreamingResponse) Reset() {
-	*x = ChatStreamingResponse{}
-	mi := &file_proto_chat_proto_msgTypes[15]
+func (x *GroupPreview) Reset() {
+	*x = GroupPreview{}
+	mi := &file_proto_chat_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChatStreamingResponse) String() string {
+func (x *GroupPreview) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChatStreamingResponse) ProtoMessage() {}
+func (*GroupPreview) ProtoMessage() {}
 
-func (x *ChatStreamingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_chat_proto_msgTypes[15]
+func (x *GroupPreview) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -876,76 +4832,79 @@ func (x *ChatStreamingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChatStreamingResponse.ProtoReflect.Descriptor instead.
-func (*ChatStreamingResponse) Descriptor() ([]byte, []int) {
-	return file_proto_chat_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use GroupPreview.ProtoReflect.Descriptor instead.
+func (*GroupPreview) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *ChatStreamingResponse) GetMember() uint64 {
+func (x *GroupPreview) GetId() uint64 {
 	if x != nil {
-		return x.Member
+		return x.Id
 	}
 	return 0
 }
 
-func (x *ChatStreamingResponse) GetUsername() string {
+func (x *GroupPreview) GetName() string {
 	if x != nil {
-		return x.Username
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ChatStreamingResponse) GetMessage() string {
+func (x *GroupPreview) GetDescription() string {
 	if x != nil {
-		return x.Message
+		return x.Description
 	}
 	return ""
 }
 
-func (x *ChatStreamingResponse) GetTimestamp() string {
+func (x *GroupPreview) GetVisibility() string {
 	if x != nil {
-		return x.Timestamp
+		return x.Visibility
 	}
 	return ""
 }
 
-func (x *ChatStreamingResponse) GetAction() Action {
+func (x *GroupPreview) GetMemberCount() int32 {
 	if x != nil {
-		return x.Action
+		return x.MemberCount
 	}
-	return Action_Create
+	return 0
 }
 
-func (x *ChatStreamingResponse) GetReadStatus() []*AnyUserStatus {
+func (x *GroupPreview) GetIsMember() bool {
 	if x != nil {
-		return x.ReadStatus
+		return x.IsMember
 	}
-	return nil
+	return false
 }
 
-// status user stream
-type StatusStreamingRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GroupId       uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+type SearchPublicGroupsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// dicari di nama dan deskripsi, kosong untuk semua grup public
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// default 20
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StatusStreamingRequest) Reset() {
-	*x = StatusStreamingRequest{}
-	mi := &file_proto_chat_proto_msgTypes[16]
+func (x *SearchPublicGroupsRequest) Reset() {
+	*x = SearchPublicGroupsRequest{}
+	mi := &file_proto_chat_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StatusStreamingRequest) String() string {
+func (x *SearchPublicGroupsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatusStreamingRequest) ProtoMessage() {}
+func (*SearchPublicGroupsRequest) ProtoMessage() {}
 
-func (x *StatusStreamingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_chat_proto_msgTypes[16]
+func (x *SearchPublicGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -956,42 +4915,56 @@ func (x *StatusStreamingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatusStreamingRequest.ProtoReflect.Descriptor instead.
-func (*StatusStreamingRequest) Descriptor() ([]byte, []int) {
-	return file_proto_chat_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use SearchPublicGroupsRequest.ProtoReflect.Descriptor instead.
+func (*SearchPublicGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *StatusStreamingRequest) GetGroupId() uint64 {
+func (x *SearchPublicGroupsRequest) GetQuery() string {
 	if x != nil {
-		return x.GroupId
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchPublicGroupsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
 	}
 	return 0
 }
 
-type StatusStreamingResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Member        uint64                 `protobuf:"varint,1,opt,name=member,proto3" json:"member,omitempty"`
-	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+func (x *SearchPublicGroupsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type SearchPublicGroupsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// member terbanyak dulu
+	Groups        []*GroupPreview `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	Total         int32           `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StatusStreamingResponse) Reset() {
-	*x = StatusStreamingResponse{}
-	mi := &file_proto_chat_proto_msgTypes[17]
+func (x *SearchPublicGroupsResponse) Reset() {
+	*x = SearchPublicGroupsResponse{}
+	mi := &file_proto_chat_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StatusStreamingResponse) String() string {
+func (x *SearchPublicGroupsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatusStreamingResponse) ProtoMessage() {}
+func (*SearchPublicGroupsResponse) ProtoMessage() {}
 
-func (x *StatusStreamingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_chat_proto_msgTypes[17]
+func (x *SearchPublicGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1002,55 +4975,91 @@ func (x *StatusStreamingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatusStreamingResponse.ProtoReflect.Descriptor instead.
-func (*StatusStreamingResponse) Descriptor() ([]byte, []int) {
-	return file_proto_chat_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use SearchPublicGroupsResponse.ProtoReflect.Descriptor instead.
+func (*SearchPublicGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *StatusStreamingResponse) GetMember() uint64 {
+func (x *SearchPublicGroupsResponse) GetGroups() []*GroupPreview {
 	if x != nil {
-		return x.Member
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *SearchPublicGroupsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
 	}
 	return 0
 }
 
-func (x *StatusStreamingResponse) GetUsername() string {
+type GetGroupPreviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupPreviewRequest) Reset() {
+	*x = GetGroupPreviewRequest{}
+	mi := &file_proto_chat_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupPreviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupPreviewRequest) ProtoMessage() {}
+
+func (x *GetGroupPreviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[77]
 	if x != nil {
-		return x.Username
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *StatusStreamingResponse) GetStatus() string {
+// Deprecated: Use GetGroupPreviewRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupPreviewRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GetGroupPreviewRequest) GetGroupId() uint64 {
 	if x != nil {
-		return x.Status
+		return x.GroupId
 	}
-	return ""
+	return 0
 }
 
-// GetGroup by user
-type GetListGroupResponse struct {
+type GetGroupPreviewResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Group         []*GroupInfo           `protobuf:"bytes,1,rep,name=group,proto3" json:"group,omitempty"`
+	Group         *GroupPreview          `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetListGroupResponse) Reset() {
-	*x = GetListGroupResponse{}
-	mi := &file_proto_chat_proto_msgTypes[18]
+func (x *GetGroupPreviewResponse) Reset() {
+	*x = GetGroupPreviewResponse{}
+	mi := &file_proto_chat_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetListGroupResponse) String() string {
+func (x *GetGroupPreviewResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetListGroupResponse) ProtoMessage() {}
+func (*GetGroupPreviewResponse) ProtoMessage() {}
 
-func (x *GetListGroupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_chat_proto_msgTypes[18]
+func (x *GetGroupPreviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1061,42 +5070,40 @@ func (x *GetListGroupResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetListGroupResponse.ProtoReflect.Descriptor instead.
-func (*GetListGroupResponse) Descriptor() ([]byte, []int) {
-	return file_proto_chat_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use GetGroupPreviewResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupPreviewResponse) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *GetListGroupResponse) GetGroup() []*GroupInfo {
+func (x *GetGroupPreviewResponse) GetGroup() *GroupPreview {
 	if x != nil {
 		return x.Group
 	}
 	return nil
 }
 
-type GroupInfo struct {
+type JoinPublicGroupRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	LastMessage   string                 `protobuf:"bytes,3,opt,name=last_message,json=lastMessage,proto3" json:"last_message,omitempty"`
+	GroupId       uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GroupInfo) Reset() {
-	*x = GroupInfo{}
-	mi := &file_proto_chat_proto_msgTypes[19]
+func (x *JoinPublicGroupRequest) Reset() {
+	*x = JoinPublicGroupRequest{}
+	mi := &file_proto_chat_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GroupInfo) String() string {
+func (x *JoinPublicGroupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupInfo) ProtoMessage() {}
+func (*JoinPublicGroupRequest) ProtoMessage() {}
 
-func (x *GroupInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_chat_proto_msgTypes[19]
+func (x *JoinPublicGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1107,28 +5114,74 @@ func (x *GroupInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupInfo.ProtoReflect.Descriptor instead.
-func (*GroupInfo) Descriptor() ([]byte, []int) {
-	return file_proto_chat_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use JoinPublicGroupRequest.ProtoReflect.Descriptor instead.
+func (*JoinPublicGroupRequest) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *GroupInfo) GetId() uint64 {
+func (x *JoinPublicGroupRequest) GetGroupId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.GroupId
 	}
 	return 0
 }
 
-func (x *GroupInfo) GetName() string {
+type JoinPublicGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       uint64                 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	MemberId      uint64                 `protobuf:"varint,2,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinPublicGroupResponse) Reset() {
+	*x = JoinPublicGroupResponse{}
+	mi := &file_proto_chat_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinPublicGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinPublicGroupResponse) ProtoMessage() {}
+
+func (x *JoinPublicGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_chat_proto_msgTypes[80]
 	if x != nil {
-		return x.Name
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GroupInfo) GetLastMessage() string {
+// Deprecated: Use JoinPublicGroupResponse.ProtoReflect.Descriptor instead.
+func (*JoinPublicGroupResponse) Descriptor() ([]byte, []int) {
+	return file_proto_chat_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *JoinPublicGroupResponse) GetGroupId() uint64 {
 	if x != nil {
-		return x.LastMessage
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *JoinPublicGroupResponse) GetMemberId() uint64 {
+	if x != nil {
+		return x.MemberId
+	}
+	return 0
+}
+
+func (x *JoinPublicGroupResponse) GetRole() string {
+	if x != nil {
+		return x.Role
 	}
 	return ""
 }
@@ -1137,11 +5190,15 @@ var File_proto_chat_proto protoreflect.FileDescriptor
 
 const file_proto_chat_proto_rawDesc = "" +
 	"\n" +
-	"\x10proto/chat.proto\x12\x02pb\x1a\x1dutils/validate/validate.proto\x1a\x18utils/google/empty.proto\"\x85\x01\n" +
+	"\x10proto/chat.proto\x12\x02pb\x1a\x1dutils/validate/validate.proto\x1a\x18utils/google/empty.proto\x1a\"utils/google/api/annotations.proto\x1a)utils/openapiv2/options/annotations.proto\x1a\x10proto/user.proto\"\xdf\x01\n" +
 	"\x11CreateChatRequest\x12\"\n" +
-	"\bgroup_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\agroupId\x12!\n" +
-	"\amessage\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\amessage\x12)\n" +
-	"\x06status\x18\x03 \x03(\v2\x11.pb.AnyUserStatusR\x06status\"D\n" +
+	"\bgroup_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\agroupId\x12\"\n" +
+	"\amessage\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\xa0\x1fR\amessage\x12)\n" +
+	"\x06status\x18\x03 \x03(\v2\x11.pb.AnyUserStatusR\x06status\x127\n" +
+	"\x0eattachment_ids\x18\x04 \x03(\x04B\x10\xfaB\r\x92\x01\n" +
+	"\x10\n" +
+	"\x18\x01\"\x042\x02 \x00R\rattachmentIds\x12\x1e\n" +
+	"\vreply_to_id\x18\x05 \x01(\x04R\treplyToId\"D\n" +
 	"\rAnyUserStatus\x12\x1b\n" +
 	"\tmember_id\x18\x01 \x01(\x04R\bmemberId\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\".\n" +
@@ -1149,20 +5206,55 @@ const file_proto_chat_proto_rawDesc = "" +
 	"\amessage\x18\x01 \x01(\tR\amessage\"Y\n" +
 	"\x11DeleteChatRequest\x12\"\n" +
 	"\bgroup_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\agroupId\x12 \n" +
+	"\achat_id\x18\x02 \x01(\x04B\a\xfaB\x042\x02 \x00R\x06chatId\"\xa9\x01\n" +
+	"\x16ForwardMessagesRequest\x12\"\n" +
+	"\bgroup_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\agroupId\x12-\n" +
+	"\bchat_ids\x18\x02 \x03(\x04B\x12\xfaB\x0f\x92\x01\f\b\x01\x10\x14\x18\x01\"\x042\x02 \x00R\achatIds\x12<\n" +
+	"\x10target_group_ids\x18\x03 \x03(\x04B\x12\xfaB\x0f\x92\x01\f\b\x01\x10\n" +
+	"\x18\x01\"\x042\x02 \x00R\x0etargetGroupIds\"C\n" +
+	"\rForwardedChat\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x04R\agroupId\x12\x17\n" +
+	"\achat_id\x18\x02 \x01(\x04R\x06chatId\"B\n" +
+	"\x17ForwardMessagesResponse\x12'\n" +
+	"\x05chats\x18\x01 \x03(\v2\x11.pb.ForwardedChatR\x05chats\"b\n" +
+	"\x1aGetMessageRevisionsRequest\x12\"\n" +
+	"\bgroup_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\agroupId\x12 \n" +
+	"\achat_id\x18\x02 \x01(\x04B\a\xfaB\x042\x02 \x00R\x06chatId\"\x9e\x01\n" +
+	"\x0fMessageRevision\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1b\n" +
+	"\tedited_by\x18\x03 \x01(\x04R\beditedBy\x12'\n" +
+	"\x0feditor_username\x18\x04 \x01(\tR\x0eeditorUsername\x12\x1b\n" +
+	"\tedited_at\x18\x05 \x01(\tR\beditedAt\"\xa8\x01\n" +
+	"\x1bGetMessageRevisionsResponse\x12-\n" +
+	"\x04chat\x18\x01 \x01(\v2\x19.pb.ChatStreamingResponseR\x04chat\x12'\n" +
+	"\x0fcurrent_message\x18\x02 \x01(\tR\x0ecurrentMessage\x121\n" +
+	"\trevisions\x18\x03 \x03(\v2\x13.pb.MessageRevisionR\trevisions\"X\n" +
+	"\x10PurgeChatRequest\x12\"\n" +
+	"\bgroup_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\agroupId\x12 \n" +
 	"\achat_id\x18\x02 \x01(\x04B\a\xfaB\x042\x02 \x00R\x06chatId\"|\n" +
 	"\x11UpdateChatRequest\x12\"\n" +
 	"\bgroup_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\agroupId\x12 \n" +
 	"\achat_id\x18\x02 \x01(\x04B\a\xfaB\x042\x02 \x00R\x06chatId\x12!\n" +
-	"\amessage\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\amessage\"N\n" +
+	"\amessage\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\amessage\"\x88\x01\n" +
 	"\x12CreateGroupRequest\x12\x1b\n" +
 	"\x04name\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04name\x12\x1b\n" +
-	"\x04desc\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04desc\"8\n" +
+	"\x04desc\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04desc\x128\n" +
+	"\n" +
+	"visibility\x18\x03 \x01(\tB\x18\xfaB\x15r\x13R\x00R\aprivateR\x06publicR\n" +
+	"visibility\"8\n" +
 	"\x12DeleteGroupRequest\x12\"\n" +
-	"\bgroup_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\agroupId\"r\n" +
+	"\bgroup_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\agroupId\"\x99\x02\n" +
 	"\x12UpdateGroupRequest\x12\"\n" +
 	"\bgroup_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\agroupId\x12\x1b\n" +
 	"\x04name\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04name\x12\x1b\n" +
-	"\x04desc\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04desc\"~\n" +
+	"\x04desc\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04desc\x12A\n" +
+	"\x13message_ttl_seconds\x18\x04 \x01(\x03B\f\xfaB\t\"\a\x18\x80\xe7\x84\x0f(\x00H\x00R\x11messageTtlSeconds\x88\x01\x01\x12;\n" +
+	"\n" +
+	"visibility\x18\x05 \x01(\tB\x16\xfaB\x13r\x11R\aprivateR\x06publicH\x01R\n" +
+	"visibility\x88\x01\x01B\x16\n" +
+	"\x14_message_ttl_secondsB\r\n" +
+	"\v_visibility\"~\n" +
 	"\x15UpdateRoleUserRequest\x12$\n" +
 	"\tmember_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\bmemberId\x12\"\n" +
 	"\bgroup_id\x18\x02 \x01(\x04B\a\xfaB\x042\x02 \x00R\agroupId\x12\x1b\n" +
@@ -1180,9 +5272,10 @@ const file_proto_chat_proto_rawDesc = "" +
 	"\x10ExitGroupRequest\x12\"\n" +
 	"\bgroup_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\agroupId\"(\n" +
 	"\x0eStatusResponse\x12\x16\n" +
-	"\x06status\x18\x01 \x01(\bR\x06status\":\n" +
+	"\x06status\x18\x01 \x01(\bR\x06status\"W\n" +
 	"\x14ChatStreamingRequest\x12\"\n" +
-	"\bgroup_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\agroupId\"\xda\x01\n" +
+	"\bgroup_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\agroupId\x12\x1b\n" +
+	"\tthread_id\x18\x02 \x01(\x04R\bthreadId\"\xba\a\n" +
 	"\x15ChatStreamingResponse\x12\x16\n" +
 	"\x06member\x18\x01 \x01(\x04R\x06member\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12\x18\n" +
@@ -1192,43 +5285,370 @@ const file_proto_chat_proto_rawDesc = "" +
 	".pb.ActionR\x06action\x121\n" +
 	"\n" +
 	"readStatus\x18\x06 \x03(\v2\x11.pb.AnyUserStatusR\n" +
-	"readStatus\"<\n" +
+	"readStatus\x12\x17\n" +
+	"\achat_id\x18\a \x01(\x04R\x06chatId\x12\x19\n" +
+	"\bgroup_id\x18\b \x01(\x04R\agroupId\x120\n" +
+	"\vattachments\x18\t \x03(\v2\x0e.pb.AttachmentR\vattachments\x12/\n" +
+	"\treactions\x18\n" +
+	" \x03(\v2\x11.pb.ReactionCountR\treactions\x12+\n" +
+	"\breply_to\x18\v \x01(\v2\x10.pb.ReplyPreviewR\areplyTo\x12$\n" +
+	"\x0ethread_root_id\x18\f \x01(\x04R\fthreadRootId\x12,\n" +
+	"\x12thread_reply_count\x18\r \x01(\x05R\x10threadReplyCount\x12\"\n" +
+	"\rlast_reply_at\x18\x0e \x01(\tR\vlastReplyAt\x12\x1a\n" +
+	"\bmentions\x18\x0f \x03(\x04R\bmentions\x12\x16\n" +
+	"\x06pinned\x18\x10 \x01(\bR\x06pinned\x12\x1b\n" +
+	"\tpinned_by\x18\x11 \x01(\x04R\bpinnedBy\x12\x1b\n" +
+	"\tpinned_at\x18\x12 \x01(\tR\bpinnedAt\x12\x16\n" +
+	"\x06typing\x18\x13 \x01(\bR\x06typing\x12\x18\n" +
+	"\adeleted\x18\x14 \x01(\bR\adeleted\x12\x1d\n" +
+	"\n" +
+	"deleted_by\x18\x15 \x01(\x04R\tdeletedBy\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\x16 \x01(\tR\tdeletedAt\x12\x1b\n" +
+	"\tedited_at\x18\x17 \x01(\tR\beditedAt\x126\n" +
+	"\x0eforwarded_from\x18\x18 \x01(\v2\x0f.pb.ForwardInfoR\rforwardedFrom\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x19 \x01(\tR\texpiresAt\x12)\n" +
+	"\aprofile\x18\x1a \x01(\v2\x0f.pb.UserProfileR\aprofile\x12%\n" +
+	"\x0esender_blocked\x18\x1b \x01(\bR\rsenderBlocked\"\x95\x01\n" +
+	"\vForwardInfo\x12\x17\n" +
+	"\achat_id\x18\x01 \x01(\x04R\x06chatId\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\x04R\agroupId\x12\x1d\n" +
+	"\n" +
+	"group_name\x18\x03 \x01(\tR\tgroupName\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\x04R\x06userId\x12\x1a\n" +
+	"\busername\x18\x05 \x01(\tR\busername\"\x8f\x01\n" +
+	"\fReplyPreview\x12\x17\n" +
+	"\achat_id\x18\x01 \x01(\x04R\x06chatId\x12\x16\n" +
+	"\x06member\x18\x02 \x01(\x04R\x06member\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x18\n" +
+	"\asnippet\x18\x04 \x01(\tR\asnippet\x12\x18\n" +
+	"\adeleted\x18\x05 \x01(\bR\adeleted\"Z\n" +
+	"\rReactionCount\x12\x14\n" +
+	"\x05emoji\x18\x01 \x01(\tR\x05emoji\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12\x1d\n" +
+	"\n" +
+	"member_ids\x18\x03 \x03(\x04R\tmemberIds\"<\n" +
 	"\x16StatusStreamingRequest\x12\"\n" +
-	"\bgroup_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\agroupId\"e\n" +
+	"\bgroup_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\agroupId\"\x83\x02\n" +
 	"\x17StatusStreamingResponse\x12\x16\n" +
 	"\x06member\x18\x01 \x01(\x04R\x06member\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12\x16\n" +
-	"\x06status\x18\x03 \x01(\tR\x06status\";\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x16\n" +
+	"\x06typing\x18\x04 \x01(\bR\x06typing\x12%\n" +
+	"\x05event\x18\x05 \x01(\x0e2\x0f.pb.StatusEventR\x05event\x122\n" +
+	"\fjoin_request\x18\x06 \x01(\v2\x0f.pb.JoinRequestR\vjoinRequest\x12)\n" +
+	"\aprofile\x18\a \x01(\v2\x0f.pb.UserProfileR\aprofile\"N\n" +
+	"\x10SetTypingRequest\x12\"\n" +
+	"\bgroup_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\agroupId\x12\x16\n" +
+	"\x06typing\x18\x02 \x01(\bR\x06typing\";\n" +
 	"\x14GetListGroupResponse\x12#\n" +
-	"\x05group\x18\x01 \x03(\v2\r.pb.GroupInfoR\x05group\"R\n" +
+	"\x05group\x18\x01 \x03(\v2\r.pb.GroupInfoR\x05group\"\xf7\x01\n" +
 	"\tGroupInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12!\n" +
-	"\flast_message\x18\x03 \x01(\tR\vlastMessage*,\n" +
+	"\flast_message\x18\x03 \x01(\tR\vlastMessage\x12!\n" +
+	"\funread_count\x18\x04 \x01(\x05R\vunreadCount\x120\n" +
+	"\x14unread_mention_count\x18\x05 \x01(\x05R\x12unreadMentionCount\x12.\n" +
+	"\x13message_ttl_seconds\x18\x06 \x01(\x03R\x11messageTtlSeconds\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\a \x01(\tR\n" +
+	"visibility\"\x99\x02\n" +
+	"\n" +
+	"Attachment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1b\n" +
+	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x03R\x04size\x12\x16\n" +
+	"\x06sha256\x18\x05 \x01(\tR\x06sha256\x12\x14\n" +
+	"\x05width\x18\x06 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\a \x01(\x05R\x06height\x12\x1a\n" +
+	"\bblurhash\x18\b \x01(\tR\bblurhash\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x12-\n" +
+	"\n" +
+	"thumbnails\x18\n" +
+	" \x03(\v2\r.pb.ThumbnailR\n" +
+	"thumbnails\"M\n" +
+	"\tThumbnail\x12\x12\n" +
+	"\x04size\x18\x01 \x01(\x05R\x04size\x12\x14\n" +
+	"\x05width\x18\x02 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x03 \x01(\x05R\x06height\"\xfa\x01\n" +
+	"\x0eAttachmentInfo\x12\"\n" +
+	"\bgroup_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\agroupId\x12'\n" +
+	"\tfile_name\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xff\x01R\bfileName\x12O\n" +
+	"\fcontent_type\x18\x03 \x01(\tB,\xfaB)r'\x10\x03\x18\x7f2!^[a-zA-Z0-9.+-]+/[a-zA-Z0-9.+-]+$R\vcontentType\x12\x1b\n" +
+	"\x04size\x18\x04 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x04size\x12-\n" +
+	"\x06sha256\x18\x05 \x01(\tB\x15\xfaB\x12r\x102\x0e^[a-f0-9]{64}$R\x06sha256\"u\n" +
+	"\x17UploadAttachmentRequest\x12(\n" +
+	"\x04info\x18\x01 \x01(\v2\x12.pb.AttachmentInfoH\x00R\x04info\x12#\n" +
+	"\x05chunk\x18\x02 \x01(\fB\v\xfaB\bz\x06\x10\x01\x18\x80\x80@H\x00R\x05chunkB\v\n" +
+	"\x04data\x12\x03\xf8B\x01\"J\n" +
+	"\x18UploadAttachmentResponse\x12.\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x0e.pb.AttachmentR\n" +
+	"attachment\"y\n" +
+	"\x19DownloadAttachmentRequest\x12,\n" +
+	"\rattachment_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\fattachmentId\x12.\n" +
+	"\x0ethumbnail_size\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\rthumbnailSize\"b\n" +
+	"\x1aDownloadAttachmentResponse\x12$\n" +
+	"\x04info\x18\x01 \x01(\v2\x0e.pb.AttachmentH\x00R\x04info\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\x06\n" +
+	"\x04data\"\x82\x01\n" +
+	"\x12AddReactionRequest\x12\"\n" +
+	"\bgroup_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\agroupId\x12 \n" +
+	"\achat_id\x18\x02 \x01(\x04B\a\xfaB\x042\x02 \x00R\x06chatId\x12&\n" +
+	"\x05emoji\x18\x03 \x01(\tB\x10\xfaB\rr\v\x10\x01( 2\x05^\\S+$R\x05emoji\"~\n" +
+	"\x15RemoveReactionRequest\x12\"\n" +
+	"\bgroup_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\agroupId\x12 \n" +
+	"\achat_id\x18\x02 \x01(\x04B\a\xfaB\x042\x02 \x00R\x06chatId\x12\x1f\n" +
+	"\x05emoji\x18\x03 \x01(\tB\t\xfaB\x06r\x04\x10\x01( R\x05emoji\"Y\n" +
+	"\x11ListThreadRequest\x12\"\n" +
+	"\bgroup_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\agroupId\x12 \n" +
+	"\achat_id\x18\x02 \x01(\x04B\a\xfaB\x042\x02 \x00R\x06chatId\"x\n" +
+	"\x12ListThreadResponse\x12-\n" +
+	"\x04root\x18\x01 \x01(\v2\x19.pb.ChatStreamingResponseR\x04root\x123\n" +
+	"\areplies\x18\x02 \x03(\v2\x19.pb.ChatStreamingResponseR\areplies\"r\n" +
+	"\x13ListMentionsRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x04R\agroupId\x12\x1f\n" +
+	"\vunread_only\x18\x02 \x01(\bR\n" +
+	"unreadOnly\x12\x1f\n" +
+	"\x05limit\x18\x03 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x00R\x05limit\"\x9f\x01\n" +
+	"\vMentionInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\x04R\agroupId\x12\x17\n" +
+	"\ais_read\x18\x03 \x01(\bR\x06isRead\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\x12-\n" +
+	"\x04chat\x18\x05 \x01(\v2\x19.pb.ChatStreamingResponseR\x04chat\"C\n" +
+	"\x14ListMentionsResponse\x12+\n" +
+	"\bmentions\x18\x01 \x03(\v2\x0f.pb.MentionInfoR\bmentions\"Y\n" +
+	"\x11PinMessageRequest\x12\"\n" +
+	"\bgroup_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\agroupId\x12 \n" +
+	"\achat_id\x18\x02 \x01(\x04B\a\xfaB\x042\x02 \x00R\x06chatId\"[\n" +
+	"\x13UnpinMessageRequest\x12\"\n" +
+	"\bgroup_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\agroupId\x12 \n" +
+	"\achat_id\x18\x02 \x01(\x04B\a\xfaB\x042\x02 \x00R\x06chatId\"?\n" +
+	"\x19ListPinnedMessagesRequest\x12\"\n" +
+	"\bgroup_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\agroupId\"M\n" +
+	"\x1aListPinnedMessagesResponse\x12/\n" +
+	"\x05chats\x18\x01 \x03(\v2\x19.pb.ChatStreamingResponseR\x05chats\"\x96\x02\n" +
+	"\x15SearchMessagesRequest\x12 \n" +
+	"\x05query\x18\x01 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xc8\x01R\x05query\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\x04R\agroupId\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\x04R\bauthorId\x12\x12\n" +
+	"\x04from\x18\x04 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x05 \x01(\tR\x02to\x12*\n" +
+	"\x0ehas_attachment\x18\x06 \x01(\bH\x00R\rhasAttachment\x88\x01\x01\x12\x1f\n" +
+	"\x05limit\x18\a \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x00R\x05limit\x12\x1f\n" +
+	"\x06offset\x18\b \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x06offsetB\x11\n" +
+	"\x0f_has_attachment\"3\n" +
+	"\tHighlight\x12\x14\n" +
+	"\x05start\x18\x01 \x01(\x05R\x05start\x12\x10\n" +
+	"\x03end\x18\x02 \x01(\x05R\x03end\"\x99\x01\n" +
+	"\tSearchHit\x12-\n" +
+	"\x04chat\x18\x01 \x01(\v2\x19.pb.ChatStreamingResponseR\x04chat\x12\x18\n" +
+	"\asnippet\x18\x02 \x01(\tR\asnippet\x12-\n" +
+	"\n" +
+	"highlights\x18\x03 \x03(\v2\r.pb.HighlightR\n" +
+	"highlights\x12\x14\n" +
+	"\x05score\x18\x04 \x01(\x01R\x05score\"Q\n" +
+	"\x16SearchMessagesResponse\x12!\n" +
+	"\x04hits\x18\x01 \x03(\v2\r.pb.SearchHitR\x04hits\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\x84\x01\n" +
+	"\x16ScheduleMessageRequest\x12\"\n" +
+	"\bgroup_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\agroupId\x12$\n" +
+	"\amessage\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xa0\x1fR\amessage\x12 \n" +
+	"\asend_at\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06sendAt\"\xbd\x01\n" +
+	"\x10ScheduledMessage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\x04R\agroupId\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x17\n" +
+	"\asend_at\x18\x04 \x01(\tR\x06sendAt\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\"\\\n" +
+	"\x17ScheduleMessageResponse\x12A\n" +
+	"\x11scheduled_message\x18\x01 \x01(\v2\x14.pb.ScheduledMessageR\x10scheduledMessage\"9\n" +
+	"\x1cListScheduledMessagesRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x04R\agroupId\"d\n" +
+	"\x1dListScheduledMessagesResponse\x12C\n" +
+	"\x12scheduled_messages\x18\x01 \x03(\v2\x14.pb.ScheduledMessageR\x11scheduledMessages\"8\n" +
+	"\x1dCancelScheduledMessageRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\x02id\"\xc5\x01\n" +
+	"\x13CreateInviteRequest\x12\"\n" +
+	"\bgroup_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\agroupId\x12*\n" +
+	"\x04role\x18\x02 \x01(\tB\x16\xfaB\x13r\x11R\x00R\x06memberR\x05adminR\x04role\x12\"\n" +
+	"\bmax_uses\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\amaxUses\x12:\n" +
+	"\x12expires_in_seconds\x18\x04 \x01(\x03B\f\xfaB\t\"\a\x18\x80\xe7\x84\x0f(\x00R\x10expiresInSeconds\"\xd7\x01\n" +
+	"\x06Invite\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\x04R\agroupId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x19\n" +
+	"\bmax_uses\x18\x04 \x01(\x05R\amaxUses\x12\x12\n" +
+	"\x04uses\x18\x05 \x01(\x05R\x04uses\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\tR\texpiresAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\a \x01(\x04R\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\":\n" +
+	"\x14CreateInviteResponse\x12\"\n" +
+	"\x06invite\x18\x01 \x01(\v2\n" +
+	".pb.InviteR\x06invite\"8\n" +
+	"\x12ListInvitesRequest\x12\"\n" +
+	"\bgroup_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\agroupId\";\n" +
+	"\x13ListInvitesResponse\x12$\n" +
+	"\ainvites\x18\x01 \x03(\v2\n" +
+	".pb.InviteR\ainvites\"V\n" +
+	"\x13RevokeInviteRequest\x12\"\n" +
+	"\bgroup_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\agroupId\x12\x1b\n" +
+	"\x04code\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04code\"4\n" +
+	"\x13JoinByInviteRequest\x12\x1d\n" +
+	"\x04code\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18 R\x04code\"b\n" +
+	"\x14JoinByInviteResponse\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x04R\agroupId\x12\x1b\n" +
+	"\tmember_id\x18\x02 \x01(\x04R\bmemberId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\"\xfa\x01\n" +
+	"\vJoinRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x19\n" +
+	"\bgroup_id\x18\x02 \x01(\x04R\agroupId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x04R\x06userId\x12\x1a\n" +
+	"\busername\x18\x04 \x01(\tR\busername\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1f\n" +
+	"\vreviewed_by\x18\a \x01(\x04R\n" +
+	"reviewedBy\x12\x1f\n" +
+	"\vreviewed_at\x18\b \x01(\tR\n" +
+	"reviewedAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\"X\n" +
+	"\x14RequestToJoinRequest\x12\"\n" +
+	"\bgroup_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\agroupId\x12\x1c\n" +
+	"\x04note\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x18\xf4\x03R\x04note\"K\n" +
+	"\x15RequestToJoinResponse\x122\n" +
+	"\fjoin_request\x18\x01 \x01(\v2\x0f.pb.JoinRequestR\vjoinRequest\"=\n" +
+	"\x17ListJoinRequestsRequest\x12\"\n" +
+	"\bgroup_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\agroupId\"P\n" +
+	"\x18ListJoinRequestsResponse\x124\n" +
+	"\rjoin_requests\x18\x01 \x03(\v2\x0f.pb.JoinRequestR\fjoinRequests\"g\n" +
+	"\x19ApproveJoinRequestRequest\x12\"\n" +
+	"\bgroup_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\agroupId\x12&\n" +
+	"\n" +
+	"request_id\x18\x02 \x01(\x04B\a\xfaB\x042\x02 \x00R\trequestId\"f\n" +
+	"\x18RejectJoinRequestRequest\x12\"\n" +
+	"\bgroup_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\agroupId\x12&\n" +
+	"\n" +
+	"request_id\x18\x02 \x01(\x04B\a\xfaB\x042\x02 \x00R\trequestId\"\xb4\x01\n" +
+	"\fGroupPreview\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x04 \x01(\tR\n" +
+	"visibility\x12!\n" +
+	"\fmember_count\x18\x05 \x01(\x05R\vmemberCount\x12\x1b\n" +
+	"\tis_member\x18\x06 \x01(\bR\bisMember\"|\n" +
+	"\x19SearchPublicGroupsRequest\x12\x1d\n" +
+	"\x05query\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x18dR\x05query\x12\x1f\n" +
+	"\x05limit\x18\x02 \x01(\x05B\t\xfaB\x06\x1a\x04\x182(\x00R\x05limit\x12\x1f\n" +
+	"\x06offset\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x06offset\"\\\n" +
+	"\x1aSearchPublicGroupsResponse\x12(\n" +
+	"\x06groups\x18\x01 \x03(\v2\x10.pb.GroupPreviewR\x06groups\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"<\n" +
+	"\x16GetGroupPreviewRequest\x12\"\n" +
+	"\bgroup_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\agroupId\"A\n" +
+	"\x17GetGroupPreviewResponse\x12&\n" +
+	"\x05group\x18\x01 \x01(\v2\x10.pb.GroupPreviewR\x05group\"<\n" +
+	"\x16JoinPublicGroupRequest\x12\"\n" +
+	"\bgroup_id\x18\x01 \x01(\x04B\a\xfaB\x042\x02 \x00R\agroupId\"e\n" +
+	"\x17JoinPublicGroupResponse\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x04R\agroupId\x12\x1b\n" +
+	"\tmember_id\x18\x02 \x01(\x04R\bmemberId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role*\xbf\x01\n" +
 	"\x06Action\x12\n" +
 	"\n" +
 	"\x06Create\x10\x00\x12\n" +
 	"\n" +
 	"\x06Update\x10\x01\x12\n" +
 	"\n" +
-	"\x06Delete\x10\x022\xb1\x06\n" +
-	"\vChatService\x12;\n" +
+	"\x06Delete\x10\x02\x12\x13\n" +
+	"\x0fAttachmentReady\x10\x03\x12\f\n" +
+	"\bReaction\x10\x04\x12\x10\n" +
+	"\fThreadUpdate\x10\x05\x12\a\n" +
+	"\x03Pin\x10\x06\x12\t\n" +
+	"\x05Unpin\x10\a\x12\n" +
+	"\n" +
+	"\x06Typing\x10\b\x12\t\n" +
+	"\x05Purge\x10\t\x12\b\n" +
+	"\x04Join\x10\n" +
+	"\x12\x11\n" +
+	"\rProfileUpdate\x10\v\x12\x14\n" +
+	"\x10AttachmentFailed\x10\f*n\n" +
+	"\vStatusEvent\x12\f\n" +
+	"\bPresence\x10\x00\x12\x11\n" +
+	"\rJoinRequested\x10\x01\x12\x17\n" +
+	"\x13JoinRequestResolved\x10\x02\x12\x12\n" +
+	"\x0eProfileUpdated\x10\x03\x12\x11\n" +
+	"\rTypingChanged\x10\x042\xc0\"\n" +
+	"\vChatService\x12c\n" +
 	"\n" +
-	"CreateChat\x12\x15.pb.CreateChatRequest\x1a\x16.pb.CreateChatResponse\x127\n" +
+	"CreateChat\x12\x15.pb.CreateChatRequest\x1a\x16.pb.CreateChatResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/groups/{group_id}/chats\x12f\n" +
 	"\n" +
-	"DeleteChat\x12\x15.pb.DeleteChatRequest\x1a\x12.pb.StatusResponse\x127\n" +
+	"DeleteChat\x12\x15.pb.DeleteChatRequest\x1a\x12.pb.StatusResponse\"-\x82\xd3\xe4\x93\x02'*%/v1/groups/{group_id}/chats/{chat_id}\x12i\n" +
 	"\n" +
-	"UpdateChat\x12\x15.pb.UpdateChatRequest\x1a\x12.pb.StatusResponse\x129\n" +
-	"\vCreateGroup\x12\x16.pb.CreateGroupRequest\x1a\x12.pb.StatusResponse\x129\n" +
-	"\vDeleteGroup\x12\x16.pb.DeleteGroupRequest\x1a\x12.pb.StatusResponse\x129\n" +
-	"\vUpdateGroup\x12\x16.pb.UpdateGroupRequest\x1a\x12.pb.StatusResponse\x125\n" +
-	"\tAddMember\x12\x14.pb.AddMemberRequest\x1a\x12.pb.StatusResponse\x12;\n" +
-	"\fRemoveMember\x12\x17.pb.RemoveMemberRequest\x1a\x12.pb.StatusResponse\x125\n" +
-	"\tExitGroup\x12\x14.pb.ExitGroupRequest\x1a\x12.pb.StatusResponse\x12?\n" +
-	"\x0eUpdateRoleUser\x12\x19.pb.UpdateRoleUserRequest\x1a\x12.pb.StatusResponse\x12F\n" +
+	"UpdateChat\x12\x15.pb.UpdateChatRequest\x1a\x12.pb.StatusResponse\"0\x82\xd3\xe4\x93\x02*:\x01*2%/v1/groups/{group_id}/chats/{chat_id}\x12v\n" +
+	"\x0fScheduleMessage\x12\x1a.pb.ScheduleMessageRequest\x1a\x1b.pb.ScheduleMessageResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/groups/{group_id}/scheduled\x12s\n" +
+	"\x15ListScheduledMessages\x12 .pb.ListScheduledMessagesRequest\x1a!.pb.ListScheduledMessagesResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/scheduled\x12k\n" +
+	"\x16CancelScheduledMessage\x12!.pb.CancelScheduledMessageRequest\x1a\x12.pb.StatusResponse\"\x1a\x82\xd3\xe4\x93\x02\x14*\x12/v1/scheduled/{id}\x12z\n" +
+	"\x0fForwardMessages\x12\x1a.pb.ForwardMessagesRequest\x1a\x1b.pb.ForwardMessagesResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/groups/{group_id}/chats:forward\x12\x8f\x01\n" +
+	"\x13GetMessageRevisions\x12\x1e.pb.GetMessageRevisionsRequest\x1a\x1f.pb.GetMessageRevisionsResponse\"7\x82\xd3\xe4\x93\x021\x12//v1/groups/{group_id}/chats/{chat_id}/revisions\x12j\n" +
+	"\tPurgeChat\x12\x14.pb.PurgeChatRequest\x1a\x12.pb.StatusResponse\"3\x82\xd3\xe4\x93\x02-\"+/v1/groups/{group_id}/chats/{chat_id}:purge\x12P\n" +
+	"\vCreateGroup\x12\x16.pb.CreateGroupRequest\x1a\x12.pb.StatusResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
+	"/v1/groups\x12X\n" +
+	"\vDeleteGroup\x12\x16.pb.DeleteGroupRequest\x1a\x12.pb.StatusResponse\"\x1d\x82\xd3\xe4\x93\x02\x17*\x15/v1/groups/{group_id}\x12[\n" +
+	"\vUpdateGroup\x12\x16.pb.UpdateGroupRequest\x1a\x12.pb.StatusResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*2\x15/v1/groups/{group_id}\x12_\n" +
+	"\tAddMember\x12\x14.pb.AddMemberRequest\x1a\x12.pb.StatusResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/groups/{group_id}/members\x12l\n" +
+	"\fRemoveMember\x12\x17.pb.RemoveMemberRequest\x1a\x12.pb.StatusResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/v1/groups/{group_id}/members:remove\x12Y\n" +
+	"\tExitGroup\x12\x14.pb.ExitGroupRequest\x1a\x12.pb.StatusResponse\"\"\x82\xd3\xe4\x93\x02\x1c\"\x1a/v1/groups/{group_id}:exit\x12z\n" +
+	"\x0eUpdateRoleUser\x12\x19.pb.UpdateRoleUserRequest\x1a\x12.pb.StatusResponse\"9\x82\xd3\xe4\x93\x023:\x01*2./v1/groups/{group_id}/members/{member_id}/role\x12n\n" +
+	"\x12SearchPublicGroups\x12\x1d.pb.SearchPublicGroupsRequest\x1a\x1e.pb.SearchPublicGroupsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/public-groups\x12q\n" +
+	"\x0fGetGroupPreview\x12\x1a.pb.GetGroupPreviewRequest\x1a\x1b.pb.GetGroupPreviewResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/groups/{group_id}/preview\x12q\n" +
+	"\x0fJoinPublicGroup\x12\x1a.pb.JoinPublicGroupRequest\x1a\x1b.pb.JoinPublicGroupResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/groups/{group_id}:join\x12k\n" +
+	"\fCreateInvite\x12\x17.pb.CreateInviteRequest\x1a\x18.pb.CreateInviteResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/groups/{group_id}/invites\x12e\n" +
+	"\vListInvites\x12\x16.pb.ListInvitesRequest\x1a\x17.pb.ListInvitesResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/groups/{group_id}/invites\x12i\n" +
+	"\fRevokeInvite\x12\x17.pb.RevokeInviteRequest\x1a\x12.pb.StatusResponse\",\x82\xd3\xe4\x93\x02&*$/v1/groups/{group_id}/invites/{code}\x12e\n" +
+	"\fJoinByInvite\x12\x17.pb.JoinByInviteRequest\x1a\x18.pb.JoinByInviteResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/invites/{code}:join\x12t\n" +
+	"\rRequestToJoin\x12\x18.pb.RequestToJoinRequest\x1a\x19.pb.RequestToJoinResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/groups/{group_id}/join-requests\x12z\n" +
+	"\x10ListJoinRequests\x12\x1b.pb.ListJoinRequestsRequest\x1a\x1c.pb.ListJoinRequestsResponse\"+\x82\xd3\xe4\x93\x02%\x12#/v1/groups/{group_id}/join-requests\x12\x89\x01\n" +
+	"\x12ApproveJoinRequest\x12\x1d.pb.ApproveJoinRequestRequest\x1a\x12.pb.StatusResponse\"@\x82\xd3\xe4\x93\x02:\"8/v1/groups/{group_id}/join-requests/{request_id}:approve\x12\x86\x01\n" +
+	"\x11RejectJoinRequest\x12\x1c.pb.RejectJoinRequestRequest\x1a\x12.pb.StatusResponse\"?\x82\xd3\xe4\x93\x029\"7/v1/groups/{group_id}/join-requests/{request_id}:reject\x12F\n" +
 	"\rChatStreaming\x12\x18.pb.ChatStreamingRequest\x1a\x19.pb.ChatStreamingResponse0\x01\x12L\n" +
-	"\x0fStatusStreaming\x12\x1a.pb.StatusStreamingRequest\x1a\x1b.pb.StatusStreamingResponse0\x01\x12@\n" +
-	"\fGetListGroup\x12\x16.google.protobuf.Empty\x1a\x18.pb.GetListGroupResponseB\x05Z\x03/pbb\x06proto3"
+	"\x0fStatusStreaming\x12\x1a.pb.StatusStreamingRequest\x1a\x1b.pb.StatusStreamingResponse0\x01\x12^\n" +
+	"\tSetTyping\x12\x14.pb.SetTypingRequest\x1a\x12.pb.StatusResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/groups/{group_id}/typing\x12u\n" +
+	"\vAddReaction\x12\x16.pb.AddReactionRequest\x1a\x12.pb.StatusResponse\":\x82\xd3\xe4\x93\x024:\x01*\"//v1/groups/{group_id}/chats/{chat_id}/reactions\x12\x80\x01\n" +
+	"\x0eRemoveReaction\x12\x19.pb.RemoveReactionRequest\x1a\x12.pb.StatusResponse\"?\x82\xd3\xe4\x93\x029*7/v1/groups/{group_id}/chats/{chat_id}/reactions/{emoji}\x12q\n" +
+	"\n" +
+	"ListThread\x12\x15.pb.ListThreadRequest\x1a\x16.pb.ListThreadResponse\"4\x82\xd3\xe4\x93\x02.\x12,/v1/groups/{group_id}/chats/{chat_id}/thread\x12j\n" +
+	"\n" +
+	"PinMessage\x12\x15.pb.PinMessageRequest\x1a\x12.pb.StatusResponse\"1\x82\xd3\xe4\x93\x02+\")/v1/groups/{group_id}/chats/{chat_id}/pin\x12n\n" +
+	"\fUnpinMessage\x12\x17.pb.UnpinMessageRequest\x1a\x12.pb.StatusResponse\"1\x82\xd3\xe4\x93\x02+*)/v1/groups/{group_id}/chats/{chat_id}/pin\x12w\n" +
+	"\x12ListPinnedMessages\x12\x1d.pb.ListPinnedMessagesRequest\x1a\x1e.pb.ListPinnedMessagesResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/groups/{group_id}/pins\x12d\n" +
+	"\x0eSearchMessages\x12\x19.pb.SearchMessagesRequest\x1a\x1a.pb.SearchMessagesResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/search/messages\x12W\n" +
+	"\fListMentions\x12\x17.pb.ListMentionsRequest\x1a\x18.pb.ListMentionsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/mentions\x12O\n" +
+	"\x10UploadAttachment\x12\x1b.pb.UploadAttachmentRequest\x1a\x1c.pb.UploadAttachmentResponse(\x01\x12U\n" +
+	"\x12DownloadAttachment\x12\x1d.pb.DownloadAttachmentRequest\x1a\x1e.pb.DownloadAttachmentResponse0\x01\x12T\n" +
+	"\fGetListGroup\x12\x16.google.protobuf.Empty\x1a\x18.pb.GetListGroupResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/v1/groupsBy\x92Aq\x12\x0f\n" +
+	"\bChat API2\x031.0ZP\n" +
+	"N\n" +
+	"\x06Bearer\x12D\b\x02\x12/JWT dari /v1/auth/login, format: Bearer <token>\x1a\rAuthorization \x02b\f\n" +
+	"\n" +
+	"\n" +
+	"\x06Bearer\x12\x00Z\x03/pbb\x06proto3"
 
 var (
 	file_proto_chat_proto_rawDescOnce sync.Once
@@ -1242,70 +5662,220 @@ func file_proto_chat_proto_rawDescGZIP() []byte {
 	return file_proto_chat_proto_rawDescData
 }
 
-var file_proto_chat_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_chat_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_proto_chat_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_proto_chat_proto_msgTypes = make([]protoimpl.MessageInfo, 81)
 var file_proto_chat_proto_goTypes = []any{
-	(Action)(0),                     // 0: pb.Action
-	(*CreateChatRequest)(nil),       // 1: pb.CreateChatRequest
-	(*AnyUserStatus)(nil),           // 2: pb.AnyUserStatus
-	(*CreateChatResponse)(nil),      // 3: pb.CreateChatResponse
-	(*DeleteChatRequest)(nil),       // 4: pb.DeleteChatRequest
-	(*UpdateChatRequest)(nil),       // 5: pb.UpdateChatRequest
-	(*CreateGroupRequest)(nil),      // 6: pb.CreateGroupRequest
-	(*DeleteGroupRequest)(nil),      // 7: pb.DeleteGroupRequest
-	(*UpdateGroupRequest)(nil),      // 8: pb.UpdateGroupRequest
-	(*UpdateRoleUserRequest)(nil),   // 9: pb.UpdateRoleUserRequest
-	(*AddMemberRequest)(nil),        // 10: pb.AddMemberRequest
-	(*RemoveMemberRequest)(nil),     // 11: pb.RemoveMemberRequest
-	(*ListUserId)(nil),              // 12: pb.ListUserId
-	(*ExitGroupRequest)(nil),        // 13: pb.ExitGroupRequest
-	(*StatusResponse)(nil),          // 14: pb.StatusResponse
-	(*ChatStreamingRequest)(nil),    // 15: pb.ChatStreamingRequest
-	(*ChatStreamingResponse)(nil),   // 16: pb.ChatStreamingResponse
-	(*StatusStreamingRequest)(nil),  // 17: pb.StatusStreamingRequest
-	(*StatusStreamingResponse)(nil), // 18: pb.StatusStreamingResponse
-	(*GetListGroupResponse)(nil),    // 19: pb.GetListGroupResponse
-	(*GroupInfo)(nil),               // 20: pb.GroupInfo
-	(*emptypb.Empty)(nil),           // 21: google.protobuf.Empty
+	(Action)(0),                           // 0: pb.Action
+	(StatusEvent)(0),                      // 1: pb.StatusEvent
+	(*CreateChatRequest)(nil),             // 2: pb.CreateChatRequest
+	(*AnyUserStatus)(nil),                 // 3: pb.AnyUserStatus
+	(*CreateChatResponse)(nil),            // 4: pb.CreateChatResponse
+	(*DeleteChatRequest)(nil),             // 5: pb.DeleteChatRequest
+	(*ForwardMessagesRequest)(nil),        // 6: pb.ForwardMessagesRequest
+	(*ForwardedChat)(nil),                 // 7: pb.ForwardedChat
+	(*ForwardMessagesResponse)(nil),       // 8: pb.ForwardMessagesResponse
+	(*GetMessageRevisionsRequest)(nil),    // 9: pb.GetMessageRevisionsRequest
+	(*MessageRevision)(nil),               // 10: pb.MessageRevision
+	(*GetMessageRevisionsResponse)(nil),   // 11: pb.GetMessageRevisionsResponse
+	(*PurgeChatRequest)(nil),              // 12: pb.PurgeChatRequest
+	(*UpdateChatRequest)(nil),             // 13: pb.UpdateChatRequest
+	(*CreateGroupRequest)(nil),            // 14: pb.CreateGroupRequest
+	(*DeleteGroupRequest)(nil),            // 15: pb.DeleteGroupRequest
+	(*UpdateGroupRequest)(nil),            // 16: pb.UpdateGroupRequest
+	(*UpdateRoleUserRequest)(nil),         // 17: pb.UpdateRoleUserRequest
+	(*AddMemberRequest)(nil),              // 18: pb.AddMemberRequest
+	(*RemoveMemberRequest)(nil),           // 19: pb.RemoveMemberRequest
+	(*ListUserId)(nil),                    // 20: pb.ListUserId
+	(*ExitGroupRequest)(nil),              // 21: pb.ExitGroupRequest
+	(*StatusResponse)(nil),                // 22: pb.StatusResponse
+	(*ChatStreamingRequest)(nil),          // 23: pb.ChatStreamingRequest
+	(*ChatStreamingResponse)(nil),         // 24: pb.ChatStreamingResponse
+	(*ForwardInfo)(nil),                   // 25: pb.ForwardInfo
+	(*ReplyPreview)(nil),                  // 26: pb.ReplyPreview
+	(*ReactionCount)(nil),                 // 27: pb.ReactionCount
+	(*StatusStreamingRequest)(nil),        // 28: pb.StatusStreamingRequest
+	(*StatusStreamingResponse)(nil),       // 29: pb.StatusStreamingResponse
+	(*SetTypingRequest)(nil),              // 30: pb.SetTypingRequest
+	(*GetListGroupResponse)(nil),          // 31: pb.GetListGroupResponse
+	(*GroupInfo)(nil),                     // 32: pb.GroupInfo
+	(*Attachment)(nil),                    // 33: pb.Attachment
+	(*Thumbnail)(nil),                     // 34: pb.Thumbnail
+	(*AttachmentInfo)(nil),                // 35: pb.AttachmentInfo
+	(*UploadAttachmentRequest)(nil),       // 36: pb.UploadAttachmentRequest
+	(*UploadAttachmentResponse)(nil),      // 37: pb.UploadAttachmentResponse
+	(*DownloadAttachmentRequest)(nil),     // 38: pb.DownloadAttachmentRequest
+	(*DownloadAttachmentResponse)(nil),    // 39: pb.DownloadAttachmentResponse
+	(*AddReactionRequest)(nil),            // 40: pb.AddReactionRequest
+	(*RemoveReactionRequest)(nil),         // 41: pb.RemoveReactionRequest
+	(*ListThreadRequest)(nil),             // 42: pb.ListThreadRequest
+	(*ListThreadResponse)(nil),            // 43: pb.ListThreadResponse
+	(*ListMentionsRequest)(nil),           // 44: pb.ListMentionsRequest
+	(*MentionInfo)(nil),                   // 45: pb.MentionInfo
+	(*ListMentionsResponse)(nil),          // 46: pb.ListMentionsResponse
+	(*PinMessageRequest)(nil),             // 47: pb.PinMessageRequest
+	(*UnpinMessageRequest)(nil),           // 48: pb.UnpinMessageRequest
+	(*ListPinnedMessagesRequest)(nil),     // 49: pb.ListPinnedMessagesRequest
+	(*ListPinnedMessagesResponse)(nil),    // 50: pb.ListPinnedMessagesResponse
+	(*SearchMessagesRequest)(nil),         // 51: pb.SearchMessagesRequest
+	(*Highlight)(nil),                     // 52: pb.Highlight
+	(*SearchHit)(nil),                     // 53: pb.SearchHit
+	(*SearchMessagesResponse)(nil),        // 54: pb.SearchMessagesResponse
+	(*ScheduleMessageRequest)(nil),        // 55: pb.ScheduleMessageRequest
+	(*ScheduledMessage)(nil),              // 56: pb.ScheduledMessage
+	(*ScheduleMessageResponse)(nil),       // 57: pb.ScheduleMessageResponse
+	(*ListScheduledMessagesRequest)(nil),  // 58: pb.ListScheduledMessagesRequest
+	(*ListScheduledMessagesResponse)(nil), // 59: pb.ListScheduledMessagesResponse
+	(*CancelScheduledMessageRequest)(nil), // 60: pb.CancelScheduledMessageRequest
+	(*CreateInviteRequest)(nil),           // 61: pb.CreateInviteRequest
+	(*Invite)(nil),                        // 62: pb.Invite
+	(*CreateInviteResponse)(nil),          // 63: pb.CreateInviteResponse
+	(*ListInvitesRequest)(nil),            // 64: pb.ListInvitesRequest
+	(*ListInvitesResponse)(nil),           // 65: pb.ListInvitesResponse
+	(*RevokeInviteRequest)(nil),           // 66: pb.RevokeInviteRequest
+	(*JoinByInviteRequest)(nil),           // 67: pb.JoinByInviteRequest
+	(*JoinByInviteResponse)(nil),          // 68: pb.JoinByInviteResponse
+	(*JoinRequest)(nil),                   // 69: pb.JoinRequest
+	(*RequestToJoinRequest)(nil),          // 70: pb.RequestToJoinRequest
+	(*RequestToJoinResponse)(nil),         // 71: pb.RequestToJoinResponse
+	(*ListJoinRequestsRequest)(nil),       // 72: pb.ListJoinRequestsRequest
+	(*ListJoinRequestsResponse)(nil),      // 73: pb.ListJoinRequestsResponse
+	(*ApproveJoinRequestRequest)(nil),     // 74: pb.ApproveJoinRequestRequest
+	(*RejectJoinRequestRequest)(nil),      // 75: pb.RejectJoinRequestRequest
+	(*GroupPreview)(nil),                  // 76: pb.GroupPreview
+	(*SearchPublicGroupsRequest)(nil),     // 77: pb.SearchPublicGroupsRequest
+	(*SearchPublicGroupsResponse)(nil),    // 78: pb.SearchPublicGroupsResponse
+	(*GetGroupPreviewRequest)(nil),        // 79: pb.GetGroupPreviewRequest
+	(*GetGroupPreviewResponse)(nil),       // 80: pb.GetGroupPreviewResponse
+	(*JoinPublicGroupRequest)(nil),        // 81: pb.JoinPublicGroupRequest
+	(*JoinPublicGroupResponse)(nil),       // 82: pb.JoinPublicGroupResponse
+	(*UserProfile)(nil),                   // 83: pb.UserProfile
+	(*emptypb.Empty)(nil),                 // 84: google.protobuf.Empty
 }
 var file_proto_chat_proto_depIdxs = []int32{
-	2,  // 0: pb.CreateChatRequest.status:type_name -> pb.AnyUserStatus
-	12, // 1: pb.AddMemberRequest.list_user_id:type_name -> pb.ListUserId
-	12, // 2: pb.RemoveMemberRequest.list_member_id:type_name -> pb.ListUserId
-	0,  // 3: pb.ChatStreamingResponse.action:type_name -> pb.Action
-	2,  // 4: pb.ChatStreamingResponse.readStatus:type_name -> pb.AnyUserStatus
-	20, // 5: pb.GetListGroupResponse.group:type_name -> pb.GroupInfo
-	1,  // 6: pb.ChatService.CreateChat:input_type -> pb.CreateChatRequest
-	4,  // 7: pb.ChatService.DeleteChat:input_type -> pb.DeleteChatRequest
-	5,  // 8: pb.ChatService.UpdateChat:input_type -> pb.UpdateChatRequest
-	6,  // 9: pb.ChatService.CreateGroup:input_type -> pb.CreateGroupRequest
-	7,  // 10: pb.ChatService.DeleteGroup:input_type -> pb.DeleteGroupRequest
-	8,  // 11: pb.ChatService.UpdateGroup:input_type -> pb.UpdateGroupRequest
-	10, // 12: pb.ChatService.AddMember:input_type -> pb.AddMemberRequest
-	11, // 13: pb.ChatService.RemoveMember:input_type -> pb.RemoveMemberRequest
-	13, // 14: pb.ChatService.ExitGroup:input_type -> pb.ExitGroupRequest
-	9,  // 15: pb.ChatService.UpdateRoleUser:input_type -> pb.UpdateRoleUserRequest
-	15, // 16: pb.ChatService.ChatStreaming:input_type -> pb.ChatStreamingRequest
-	17, // 17: pb.ChatService.StatusStreaming:input_type -> pb.StatusStreamingRequest
-	21, // 18: pb.ChatService.GetListGroup:input_type -> google.protobuf.Empty
-	3,  // 19: pb.ChatService.CreateChat:output_type -> pb.CreateChatResponse
-	14, // 20: pb.ChatService.DeleteChat:output_type -> pb.StatusResponse
-	14, // 21: pb.ChatService.UpdateChat:output_type -> pb.StatusResponse
-	14, // 22: pb.ChatService.CreateGroup:output_type -> pb.StatusResponse
-	14, // 23: pb.ChatService.DeleteGroup:output_type -> pb.StatusResponse
-	14, // 24: pb.ChatService.UpdateGroup:output_type -> pb.StatusResponse
-	14, // 25: pb.ChatService.AddMember:output_type -> pb.StatusResponse
-	14, // 26: pb.ChatService.RemoveMember:output_type -> pb.StatusResponse
-	14, // 27: pb.ChatService.ExitGroup:output_type -> pb.StatusResponse
-	14, // 28: pb.ChatService.UpdateRoleUser:output_type -> pb.StatusResponse
-	16, // 29: pb.ChatService.ChatStreaming:output_type -> pb.ChatStreamingResponse
-	18, // 30: pb.ChatService.StatusStreaming:output_type -> pb.StatusStreamingResponse
-	19, // 31: pb.ChatService.GetListGroup:output_type -> pb.GetListGroupResponse
-	19, // [19:32] is the sub-list for method output_type
-	6,  // [6:19] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	3,  // 0: pb.CreateChatRequest.status:type_name -> pb.AnyUserStatus
+	7,  // 1: pb.ForwardMessagesResponse.chats:type_name -> pb.ForwardedChat
+	24, // 2: pb.GetMessageRevisionsResponse.chat:type_name -> pb.ChatStreamingResponse
+	10, // 3: pb.GetMessageRevisionsResponse.revisions:type_name -> pb.MessageRevision
+	20, // 4: pb.AddMemberRequest.list_user_id:type_name -> pb.ListUserId
+	20, // 5: pb.RemoveMemberRequest.list_member_id:type_name -> pb.ListUserId
+	0,  // 6: pb.ChatStreamingResponse.action:type_name -> pb.Action
+	3,  // 7: pb.ChatStreamingResponse.readStatus:type_name -> pb.AnyUserStatus
+	33, // 8: pb.ChatStreamingResponse.attachments:type_name -> pb.Attachment
+	27, // 9: pb.ChatStreamingResponse.reactions:type_name -> pb.ReactionCount
+	26, // 10: pb.ChatStreamingResponse.reply_to:type_name -> pb.ReplyPreview
+	25, // 11: pb.ChatStreamingResponse.forwarded_from:type_name -> pb.ForwardInfo
+	83, // 12: pb.ChatStreamingResponse.profile:type_name -> pb.UserProfile
+	1,  // 13: pb.StatusStreamingResponse.event:type_name -> pb.StatusEvent
+	69, // 14: pb.StatusStreamingResponse.join_request:type_name -> pb.JoinRequest
+	83, // 15: pb.StatusStreamingResponse.profile:type_name -> pb.UserProfile
+	32, // 16: pb.GetListGroupResponse.group:type_name -> pb.GroupInfo
+	34, // 17: pb.Attachment.thumbnails:type_name -> pb.Thumbnail
+	35, // 18: pb.UploadAttachmentRequest.info:type_name -> pb.AttachmentInfo
+	33, // 19: pb.UploadAttachmentResponse.attachment:type_name -> pb.Attachment
+	33, // 20: pb.DownloadAttachmentResponse.info:type_name -> pb.Attachment
+	24, // 21: pb.ListThreadResponse.root:type_name -> pb.ChatStreamingResponse
+	24, // 22: pb.ListThreadResponse.replies:type_name -> pb.ChatStreamingResponse
+	24, // 23: pb.MentionInfo.chat:type_name -> pb.ChatStreamingResponse
+	45, // 24: pb.ListMentionsResponse.mentions:type_name -> pb.MentionInfo
+	24, // 25: pb.ListPinnedMessagesResponse.chats:type_name -> pb.ChatStreamingResponse
+	24, // 26: pb.SearchHit.chat:type_name -> pb.ChatStreamingResponse
+	52, // 27: pb.SearchHit.highlights:type_name -> pb.Highlight
+	53, // 28: pb.SearchMessagesResponse.hits:type_name -> pb.SearchHit
+	56, // 29: pb.ScheduleMessageResponse.scheduled_message:type_name -> pb.ScheduledMessage
+	56, // 30: pb.ListScheduledMessagesResponse.scheduled_messages:type_name -> pb.ScheduledMessage
+	62, // 31: pb.CreateInviteResponse.invite:type_name -> pb.Invite
+	62, // 32: pb.ListInvitesResponse.invites:type_name -> pb.Invite
+	69, // 33: pb.RequestToJoinResponse.join_request:type_name -> pb.JoinRequest
+	69, // 34: pb.ListJoinRequestsResponse.join_requests:type_name -> pb.JoinRequest
+	76, // 35: pb.SearchPublicGroupsResponse.groups:type_name -> pb.GroupPreview
+	76, // 36: pb.GetGroupPreviewResponse.group:type_name -> pb.GroupPreview
+	2,  // 37: pb.ChatService.CreateChat:input_type -> pb.CreateChatRequest
+	5,  // 38: pb.ChatService.DeleteChat:input_type -> pb.DeleteChatRequest
+	13, // 39: pb.ChatService.UpdateChat:input_type -> pb.UpdateChatRequest
+	55, // 40: pb.ChatService.ScheduleMessage:input_type -> pb.ScheduleMessageRequest
+	58, // 41: pb.ChatService.ListScheduledMessages:input_type -> pb.ListScheduledMessagesRequest
+	60, // 42: pb.ChatService.CancelScheduledMessage:input_type -> pb.CancelScheduledMessageRequest
+	6,  // 43: pb.ChatService.ForwardMessages:input_type -> pb.ForwardMessagesRequest
+	9,  // 44: pb.ChatService.GetMessageRevisions:input_type -> pb.GetMessageRevisionsRequest
+	12, // 45: pb.ChatService.PurgeChat:input_type -> pb.PurgeChatRequest
+	14, // 46: pb.ChatService.CreateGroup:input_type -> pb.CreateGroupRequest
+	15, // 47: pb.ChatService.DeleteGroup:input_type -> pb.DeleteGroupRequest
+	16, // 48: pb.ChatService.UpdateGroup:input_type -> pb.UpdateGroupRequest
+	18, // 49: pb.ChatService.AddMember:input_type -> pb.AddMemberRequest
+	19, // 50: pb.ChatService.RemoveMember:input_type -> pb.RemoveMemberRequest
+	21, // 51: pb.ChatService.ExitGroup:input_type -> pb.ExitGroupRequest
+	17, // 52: pb.ChatService.UpdateRoleUser:input_type -> pb.UpdateRoleUserRequest
+	77, // 53: pb.ChatService.SearchPublicGroups:input_type -> pb.SearchPublicGroupsRequest
+	79, // 54: pb.ChatService.GetGroupPreview:input_type -> pb.GetGroupPreviewRequest
+	81, // 55: pb.ChatService.JoinPublicGroup:input_type -> pb.JoinPublicGroupRequest
+	61, // 56: pb.ChatService.CreateInvite:input_type -> pb.CreateInviteRequest
+	64, // 57: pb.ChatService.ListInvites:input_type -> pb.ListInvitesRequest
+	66, // 58: pb.ChatService.RevokeInvite:input_type -> pb.RevokeInviteRequest
+	67, // 59: pb.ChatService.JoinByInvite:input_type -> pb.JoinByInviteRequest
+	70, // 60: pb.ChatService.RequestToJoin:input_type -> pb.RequestToJoinRequest
+	72, // 61: pb.ChatService.ListJoinRequests:input_type -> pb.ListJoinRequestsRequest
+	74, // 62: pb.ChatService.ApproveJoinRequest:input_type -> pb.ApproveJoinRequestRequest
+	75, // 63: pb.ChatService.RejectJoinRequest:input_type -> pb.RejectJoinRequestRequest
+	23, // 64: pb.ChatService.ChatStreaming:input_type -> pb.ChatStreamingRequest
+	28, // 65: pb.ChatService.StatusStreaming:input_type -> pb.StatusStreamingRequest
+	30, // 66: pb.ChatService.SetTyping:input_type -> pb.SetTypingRequest
+	40, // 67: pb.ChatService.AddReaction:input_type -> pb.AddReactionRequest
+	41, // 68: pb.ChatService.RemoveReaction:input_type -> pb.RemoveReactionRequest
+	42, // 69: pb.ChatService.ListThread:input_type -> pb.ListThreadRequest
+	47, // 70: pb.ChatService.PinMessage:input_type -> pb.PinMessageRequest
+	48, // 71: pb.ChatService.UnpinMessage:input_type -> pb.UnpinMessageRequest
+	49, // 72: pb.ChatService.ListPinnedMessages:input_type -> pb.ListPinnedMessagesRequest
+	51, // 73: pb.ChatService.SearchMessages:input_type -> pb.SearchMessagesRequest
+	44, // 74: pb.ChatService.ListMentions:input_type -> pb.ListMentionsRequest
+	36, // 75: pb.ChatService.UploadAttachment:input_type -> pb.UploadAttachmentRequest
+	38, // 76: pb.ChatService.DownloadAttachment:input_type -> pb.DownloadAttachmentRequest
+	84, // 77: pb.ChatService.GetListGroup:input_type -> google.protobuf.Empty
+	4,  // 78: pb.ChatService.CreateChat:output_type -> pb.CreateChatResponse
+	22, // 79: pb.ChatService.DeleteChat:output_type -> pb.StatusResponse
+	22, // 80: pb.ChatService.UpdateChat:output_type -> pb.StatusResponse
+	57, // 81: pb.ChatService.ScheduleMessage:output_type -> pb.ScheduleMessageResponse
+	59, // 82: pb.ChatService.ListScheduledMessages:output_type -> pb.ListScheduledMessagesResponse
+	22, // 83: pb.ChatService.CancelScheduledMessage:output_type -> pb.StatusResponse
+	8,  // 84: pb.ChatService.ForwardMessages:output_type -> pb.ForwardMessagesResponse
+	11, // 85: pb.ChatService.GetMessageRevisions:output_type -> pb.GetMessageRevisionsResponse
+	22, // 86: pb.ChatService.PurgeChat:output_type -> pb.StatusResponse
+	22, // 87: pb.ChatService.CreateGroup:output_type -> pb.StatusResponse
+	22, // 88: pb.ChatService.DeleteGroup:output_type -> pb.StatusResponse
+	22, // 89: pb.ChatService.UpdateGroup:output_type -> pb.StatusResponse
+	22, // 90: pb.ChatService.AddMember:output_type -> pb.StatusResponse
+	22, // 91: pb.ChatService.RemoveMember:output_type -> pb.StatusResponse
+	22, // 92: pb.ChatService.ExitGroup:output_type -> pb.StatusResponse
+	22, // 93: pb.ChatService.UpdateRoleUser:output_type -> pb.StatusResponse
+	78, // 94: pb.ChatService.SearchPublicGroups:output_type -> pb.SearchPublicGroupsResponse
+	80, // 95: pb.ChatService.GetGroupPreview:output_type -> pb.GetGroupPreviewResponse
+	82, // 96: pb.ChatService.JoinPublicGroup:output_type -> pb.JoinPublicGroupResponse
+	63, // 97: pb.ChatService.CreateInvite:output_type -> pb.CreateInviteResponse
+	65, // 98: pb.ChatService.ListInvites:output_type -> pb.ListInvitesResponse
+	22, // 99: pb.ChatService.RevokeInvite:output_type -> pb.StatusResponse
+	68, // 100: pb.ChatService.JoinByInvite:output_type -> pb.JoinByInviteResponse
+	71, // 101: pb.ChatService.RequestToJoin:output_type -> pb.RequestToJoinResponse
+	73, // 102: pb.ChatService.ListJoinRequests:output_type -> pb.ListJoinRequestsResponse
+	22, // 103: pb.ChatService.ApproveJoinRequest:output_type -> pb.StatusResponse
+	22, // 104: pb.ChatService.RejectJoinRequest:output_type -> pb.StatusResponse
+	24, // 105: pb.ChatService.ChatStreaming:output_type -> pb.ChatStreamingResponse
+	29, // 106: pb.ChatService.StatusStreaming:output_type -> pb.StatusStreamingResponse
+	22, // 107: pb.ChatService.SetTyping:output_type -> pb.StatusResponse
+	22, // 108: pb.ChatService.AddReaction:output_type -> pb.StatusResponse
+	22, // 109: pb.ChatService.RemoveReaction:output_type -> pb.StatusResponse
+	43, // 110: pb.ChatService.ListThread:output_type -> pb.ListThreadResponse
+	22, // 111: pb.ChatService.PinMessage:output_type -> pb.StatusResponse
+	22, // 112: pb.ChatService.UnpinMessage:output_type -> pb.StatusResponse
+	50, // 113: pb.ChatService.ListPinnedMessages:output_type -> pb.ListPinnedMessagesResponse
+	54, // 114: pb.ChatService.SearchMessages:output_type -> pb.SearchMessagesResponse
+	46, // 115: pb.ChatService.ListMentions:output_type -> pb.ListMentionsResponse
+	37, // 116: pb.ChatService.UploadAttachment:output_type -> pb.UploadAttachmentResponse
+	39, // 117: pb.ChatService.DownloadAttachment:output_type -> pb.DownloadAttachmentResponse
+	31, // 118: pb.ChatService.GetListGroup:output_type -> pb.GetListGroupResponse
+	78, // [78:119] is the sub-list for method output_type
+	37, // [37:78] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
 }
 
 func init() { file_proto_chat_proto_init() }
@@ -1313,13 +5883,24 @@ func file_proto_chat_proto_init() {
 	if File_proto_chat_proto != nil {
 		return
 	}
+	file_proto_user_proto_init()
+	file_proto_chat_proto_msgTypes[14].OneofWrappers = []any{}
+	file_proto_chat_proto_msgTypes[34].OneofWrappers = []any{
+		(*UploadAttachmentRequest_Info)(nil),
+		(*UploadAttachmentRequest_Chunk)(nil),
+	}
+	file_proto_chat_proto_msgTypes[37].OneofWrappers = []any{
+		(*DownloadAttachmentResponse_Info)(nil),
+		(*DownloadAttachmentResponse_Chunk)(nil),
+	}
+	file_proto_chat_proto_msgTypes[49].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_chat_proto_rawDesc), len(file_proto_chat_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   20,
+			NumEnums:      2,
+			NumMessages:   81,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
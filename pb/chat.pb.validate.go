@@ -68,10 +68,10 @@ func (m *CreateChatRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetMessage()) < 1 {
+	if utf8.RuneCountInString(m.GetMessage()) > 4000 {
 		err := CreateChatRequestValidationError{
 			field:  "Message",
-			reason: "value length must be at least 1 runes",
+			reason: "value length must be at most 4000 runes",
 		}
 		if !all {
 			return err
@@ -113,6 +113,50 @@ func (m *CreateChatRequest) validate(all bool) error {
 
 	}
 
+	if len(m.GetAttachmentIds()) > 10 {
+		err := CreateChatRequestValidationError{
+			field:  "AttachmentIds",
+			reason: "value must contain no more than 10 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_CreateChatRequest_AttachmentIds_Unique := make(map[uint64]struct{}, len(m.GetAttachmentIds()))
+
+	for idx, item := range m.GetAttachmentIds() {
+		_, _ = idx, item
+
+		if _, exists := _CreateChatRequest_AttachmentIds_Unique[item]; exists {
+			err := CreateChatRequestValidationError{
+				field:  fmt.Sprintf("AttachmentIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_CreateChatRequest_AttachmentIds_Unique[item] = struct{}{}
+		}
+
+		if item <= 0 {
+			err := CreateChatRequestValidationError{
+				field:  fmt.Sprintf("AttachmentIds[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for ReplyToId
+
 	if len(errors) > 0 {
 		return CreateChatRequestMultiError(errors)
 	}
@@ -525,22 +569,22 @@ var _ interface {
 	ErrorName() string
 } = DeleteChatRequestValidationError{}
 
-// Validate checks the field values on UpdateChatRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *UpdateChatRequest) Validate() error {
+// Validate checks the field values on ForwardMessagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ForwardMessagesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateChatRequest with the rules
+// ValidateAll checks the field values on ForwardMessagesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateChatRequestMultiError, or nil if none found.
-func (m *UpdateChatRequest) ValidateAll() error {
+// ForwardMessagesRequestMultiError, or nil if none found.
+func (m *ForwardMessagesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateChatRequest) validate(all bool) error {
+func (m *ForwardMessagesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -548,7 +592,7 @@ func (m *UpdateChatRequest) validate(all bool) error {
 	var errors []error
 
 	if m.GetGroupId() <= 0 {
-		err := UpdateChatRequestValidationError{
+		err := ForwardMessagesRequestValidationError{
 			field:  "GroupId",
 			reason: "value must be greater than 0",
 		}
@@ -558,10 +602,10 @@ func (m *UpdateChatRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetChatId() <= 0 {
-		err := UpdateChatRequestValidationError{
-			field:  "ChatId",
-			reason: "value must be greater than 0",
+	if l := len(m.GetChatIds()); l < 1 || l > 20 {
+		err := ForwardMessagesRequestValidationError{
+			field:  "ChatIds",
+			reason: "value must contain between 1 and 20 items, inclusive",
 		}
 		if !all {
 			return err
@@ -569,10 +613,41 @@ func (m *UpdateChatRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetMessage()) < 1 {
-		err := UpdateChatRequestValidationError{
-			field:  "Message",
-			reason: "value length must be at least 1 runes",
+	_ForwardMessagesRequest_ChatIds_Unique := make(map[uint64]struct{}, len(m.GetChatIds()))
+
+	for idx, item := range m.GetChatIds() {
+		_, _ = idx, item
+
+		if _, exists := _ForwardMessagesRequest_ChatIds_Unique[item]; exists {
+			err := ForwardMessagesRequestValidationError{
+				field:  fmt.Sprintf("ChatIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_ForwardMessagesRequest_ChatIds_Unique[item] = struct{}{}
+		}
+
+		if item <= 0 {
+			err := ForwardMessagesRequestValidationError{
+				field:  fmt.Sprintf("ChatIds[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if l := len(m.GetTargetGroupIds()); l < 1 || l > 10 {
+		err := ForwardMessagesRequestValidationError{
+			field:  "TargetGroupIds",
+			reason: "value must contain between 1 and 10 items, inclusive",
 		}
 		if !all {
 			return err
@@ -580,20 +655,51 @@ func (m *UpdateChatRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	_ForwardMessagesRequest_TargetGroupIds_Unique := make(map[uint64]struct{}, len(m.GetTargetGroupIds()))
+
+	for idx, item := range m.GetTargetGroupIds() {
+		_, _ = idx, item
+
+		if _, exists := _ForwardMessagesRequest_TargetGroupIds_Unique[item]; exists {
+			err := ForwardMessagesRequestValidationError{
+				field:  fmt.Sprintf("TargetGroupIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_ForwardMessagesRequest_TargetGroupIds_Unique[item] = struct{}{}
+		}
+
+		if item <= 0 {
+			err := ForwardMessagesRequestValidationError{
+				field:  fmt.Sprintf("TargetGroupIds[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return UpdateChatRequestMultiError(errors)
+		return ForwardMessagesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateChatRequestMultiError is an error wrapping multiple validation errors
-// returned by UpdateChatRequest.ValidateAll() if the designated constraints
-// aren't met.
-type UpdateChatRequestMultiError []error
+// ForwardMessagesRequestMultiError is an error wrapping multiple validation
+// errors returned by ForwardMessagesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ForwardMessagesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateChatRequestMultiError) Error() string {
+func (m ForwardMessagesRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -602,11 +708,11 @@ func (m UpdateChatRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateChatRequestMultiError) AllErrors() []error { return m }
+func (m ForwardMessagesRequestMultiError) AllErrors() []error { return m }
 
-// UpdateChatRequestValidationError is the validation error returned by
-// UpdateChatRequest.Validate if the designated constraints aren't met.
-type UpdateChatRequestValidationError struct {
+// ForwardMessagesRequestValidationError is the validation error returned by
+// ForwardMessagesRequest.Validate if the designated constraints aren't met.
+type ForwardMessagesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -614,24 +720,24 @@ type UpdateChatRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateChatRequestValidationError) Field() string { return e.field }
+func (e ForwardMessagesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateChatRequestValidationError) Reason() string { return e.reason }
+func (e ForwardMessagesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateChatRequestValidationError) Cause() error { return e.cause }
+func (e ForwardMessagesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateChatRequestValidationError) Key() bool { return e.key }
+func (e ForwardMessagesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateChatRequestValidationError) ErrorName() string {
-	return "UpdateChatRequestValidationError"
+func (e ForwardMessagesRequestValidationError) ErrorName() string {
+	return "ForwardMessagesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateChatRequestValidationError) Error() string {
+func (e ForwardMessagesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -643,14 +749,14 @@ func (e UpdateChatRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateChatRequest.%s: %s%s",
+		"invalid %sForwardMessagesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateChatRequestValidationError{}
+var _ error = ForwardMessagesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -658,66 +764,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateChatRequestValidationError{}
+} = ForwardMessagesRequestValidationError{}
 
-// Validate checks the field values on CreateGroupRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateGroupRequest) Validate() error {
+// Validate checks the field values on ForwardedChat with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ForwardedChat) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateGroupRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateGroupRequestMultiError, or nil if none found.
-func (m *CreateGroupRequest) ValidateAll() error {
+// ValidateAll checks the field values on ForwardedChat with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ForwardedChatMultiError, or
+// nil if none found.
+func (m *ForwardedChat) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateGroupRequest) validate(all bool) error {
+func (m *ForwardedChat) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetName()) < 1 {
-		err := CreateGroupRequestValidationError{
-			field:  "Name",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for GroupId
 
-	if utf8.RuneCountInString(m.GetDesc()) < 1 {
-		err := CreateGroupRequestValidationError{
-			field:  "Desc",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for ChatId
 
 	if len(errors) > 0 {
-		return CreateGroupRequestMultiError(errors)
+		return ForwardedChatMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateGroupRequestMultiError is an error wrapping multiple validation errors
-// returned by CreateGroupRequest.ValidateAll() if the designated constraints
+// ForwardedChatMultiError is an error wrapping multiple validation errors
+// returned by ForwardedChat.ValidateAll() if the designated constraints
 // aren't met.
-type CreateGroupRequestMultiError []error
+type ForwardedChatMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateGroupRequestMultiError) Error() string {
+func (m ForwardedChatMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -726,11 +814,11 @@ func (m CreateGroupRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateGroupRequestMultiError) AllErrors() []error { return m }
+func (m ForwardedChatMultiError) AllErrors() []error { return m }
 
-// CreateGroupRequestValidationError is the validation error returned by
-// CreateGroupRequest.Validate if the designated constraints aren't met.
-type CreateGroupRequestValidationError struct {
+// ForwardedChatValidationError is the validation error returned by
+// ForwardedChat.Validate if the designated constraints aren't met.
+type ForwardedChatValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -738,24 +826,22 @@ type CreateGroupRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateGroupRequestValidationError) Field() string { return e.field }
+func (e ForwardedChatValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateGroupRequestValidationError) Reason() string { return e.reason }
+func (e ForwardedChatValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateGroupRequestValidationError) Cause() error { return e.cause }
+func (e ForwardedChatValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateGroupRequestValidationError) Key() bool { return e.key }
+func (e ForwardedChatValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateGroupRequestValidationError) ErrorName() string {
-	return "CreateGroupRequestValidationError"
-}
+func (e ForwardedChatValidationError) ErrorName() string { return "ForwardedChatValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateGroupRequestValidationError) Error() string {
+func (e ForwardedChatValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -767,14 +853,14 @@ func (e CreateGroupRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateGroupRequest.%s: %s%s",
+		"invalid %sForwardedChat.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateGroupRequestValidationError{}
+var _ error = ForwardedChatValidationError{}
 
 var _ interface {
 	Field() string
@@ -782,55 +868,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateGroupRequestValidationError{}
+} = ForwardedChatValidationError{}
 
-// Validate checks the field values on DeleteGroupRequest with the rules
+// Validate checks the field values on ForwardMessagesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteGroupRequest) Validate() error {
+func (m *ForwardMessagesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteGroupRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ForwardMessagesResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteGroupRequestMultiError, or nil if none found.
-func (m *DeleteGroupRequest) ValidateAll() error {
+// ForwardMessagesResponseMultiError, or nil if none found.
+func (m *ForwardMessagesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteGroupRequest) validate(all bool) error {
+func (m *ForwardMessagesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetGroupId() <= 0 {
-		err := DeleteGroupRequestValidationError{
-			field:  "GroupId",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
+	for idx, item := range m.GetChats() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ForwardMessagesResponseValidationError{
+						field:  fmt.Sprintf("Chats[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ForwardMessagesResponseValidationError{
+						field:  fmt.Sprintf("Chats[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ForwardMessagesResponseValidationError{
+					field:  fmt.Sprintf("Chats[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
+
 	}
 
 	if len(errors) > 0 {
-		return DeleteGroupRequestMultiError(errors)
+		return ForwardMessagesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteGroupRequestMultiError is an error wrapping multiple validation errors
-// returned by DeleteGroupRequest.ValidateAll() if the designated constraints
-// aren't met.
-type DeleteGroupRequestMultiError []error
+// ForwardMessagesResponseMultiError is an error wrapping multiple validation
+// errors returned by ForwardMessagesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ForwardMessagesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteGroupRequestMultiError) Error() string {
+func (m ForwardMessagesResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -839,11 +948,11 @@ func (m DeleteGroupRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteGroupRequestMultiError) AllErrors() []error { return m }
+func (m ForwardMessagesResponseMultiError) AllErrors() []error { return m }
 
-// DeleteGroupRequestValidationError is the validation error returned by
-// DeleteGroupRequest.Validate if the designated constraints aren't met.
-type DeleteGroupRequestValidationError struct {
+// ForwardMessagesResponseValidationError is the validation error returned by
+// ForwardMessagesResponse.Validate if the designated constraints aren't met.
+type ForwardMessagesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -851,24 +960,24 @@ type DeleteGroupRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteGroupRequestValidationError) Field() string { return e.field }
+func (e ForwardMessagesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteGroupRequestValidationError) Reason() string { return e.reason }
+func (e ForwardMessagesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteGroupRequestValidationError) Cause() error { return e.cause }
+func (e ForwardMessagesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteGroupRequestValidationError) Key() bool { return e.key }
+func (e ForwardMessagesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteGroupRequestValidationError) ErrorName() string {
-	return "DeleteGroupRequestValidationError"
+func (e ForwardMessagesResponseValidationError) ErrorName() string {
+	return "ForwardMessagesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteGroupRequestValidationError) Error() string {
+func (e ForwardMessagesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -880,14 +989,14 @@ func (e DeleteGroupRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteGroupRequest.%s: %s%s",
+		"invalid %sForwardMessagesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteGroupRequestValidationError{}
+var _ error = ForwardMessagesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -895,24 +1004,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteGroupRequestValidationError{}
+} = ForwardMessagesResponseValidationError{}
 
-// Validate checks the field values on UpdateGroupRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetMessageRevisionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateGroupRequest) Validate() error {
+func (m *GetMessageRevisionsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateGroupRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetMessageRevisionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateGroupRequestMultiError, or nil if none found.
-func (m *UpdateGroupRequest) ValidateAll() error {
+// GetMessageRevisionsRequestMultiError, or nil if none found.
+func (m *GetMessageRevisionsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateGroupRequest) validate(all bool) error {
+func (m *GetMessageRevisionsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -920,7 +1029,7 @@ func (m *UpdateGroupRequest) validate(all bool) error {
 	var errors []error
 
 	if m.GetGroupId() <= 0 {
-		err := UpdateGroupRequestValidationError{
+		err := GetMessageRevisionsRequestValidationError{
 			field:  "GroupId",
 			reason: "value must be greater than 0",
 		}
@@ -930,21 +1039,10 @@ func (m *UpdateGroupRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetName()) < 1 {
-		err := UpdateGroupRequestValidationError{
-			field:  "Name",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetDesc()) < 1 {
-		err := UpdateGroupRequestValidationError{
-			field:  "Desc",
-			reason: "value length must be at least 1 runes",
+	if m.GetChatId() <= 0 {
+		err := GetMessageRevisionsRequestValidationError{
+			field:  "ChatId",
+			reason: "value must be greater than 0",
 		}
 		if !all {
 			return err
@@ -953,19 +1051,19 @@ func (m *UpdateGroupRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return UpdateGroupRequestMultiError(errors)
+		return GetMessageRevisionsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateGroupRequestMultiError is an error wrapping multiple validation errors
-// returned by UpdateGroupRequest.ValidateAll() if the designated constraints
-// aren't met.
-type UpdateGroupRequestMultiError []error
+// GetMessageRevisionsRequestMultiError is an error wrapping multiple
+// validation errors returned by GetMessageRevisionsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetMessageRevisionsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateGroupRequestMultiError) Error() string {
+func (m GetMessageRevisionsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -974,11 +1072,11 @@ func (m UpdateGroupRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateGroupRequestMultiError) AllErrors() []error { return m }
+func (m GetMessageRevisionsRequestMultiError) AllErrors() []error { return m }
 
-// UpdateGroupRequestValidationError is the validation error returned by
-// UpdateGroupRequest.Validate if the designated constraints aren't met.
-type UpdateGroupRequestValidationError struct {
+// GetMessageRevisionsRequestValidationError is the validation error returned
+// by GetMessageRevisionsRequest.Validate if the designated constraints aren't met.
+type GetMessageRevisionsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -986,24 +1084,24 @@ type UpdateGroupRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateGroupRequestValidationError) Field() string { return e.field }
+func (e GetMessageRevisionsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateGroupRequestValidationError) Reason() string { return e.reason }
+func (e GetMessageRevisionsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateGroupRequestValidationError) Cause() error { return e.cause }
+func (e GetMessageRevisionsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateGroupRequestValidationError) Key() bool { return e.key }
+func (e GetMessageRevisionsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateGroupRequestValidationError) ErrorName() string {
-	return "UpdateGroupRequestValidationError"
+func (e GetMessageRevisionsRequestValidationError) ErrorName() string {
+	return "GetMessageRevisionsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateGroupRequestValidationError) Error() string {
+func (e GetMessageRevisionsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1015,14 +1113,14 @@ func (e UpdateGroupRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateGroupRequest.%s: %s%s",
+		"invalid %sGetMessageRevisionsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateGroupRequestValidationError{}
+var _ error = GetMessageRevisionsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1030,43 +1128,310 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateGroupRequestValidationError{}
+} = GetMessageRevisionsRequestValidationError{}
 
-// Validate checks the field values on UpdateRoleUserRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateRoleUserRequest) Validate() error {
+// Validate checks the field values on MessageRevision with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MessageRevision) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateRoleUserRequest with the rules
+// ValidateAll checks the field values on MessageRevision with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateRoleUserRequestMultiError, or nil if none found.
-func (m *UpdateRoleUserRequest) ValidateAll() error {
+// MessageRevisionMultiError, or nil if none found.
+func (m *MessageRevision) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateRoleUserRequest) validate(all bool) error {
+func (m *MessageRevision) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetMemberId() <= 0 {
-		err := UpdateRoleUserRequestValidationError{
-			field:  "MemberId",
-			reason: "value must be greater than 0",
+	// no validation rules for Id
+
+	// no validation rules for Message
+
+	// no validation rules for EditedBy
+
+	// no validation rules for EditorUsername
+
+	// no validation rules for EditedAt
+
+	if len(errors) > 0 {
+		return MessageRevisionMultiError(errors)
+	}
+
+	return nil
+}
+
+// MessageRevisionMultiError is an error wrapping multiple validation errors
+// returned by MessageRevision.ValidateAll() if the designated constraints
+// aren't met.
+type MessageRevisionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MessageRevisionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MessageRevisionMultiError) AllErrors() []error { return m }
+
+// MessageRevisionValidationError is the validation error returned by
+// MessageRevision.Validate if the designated constraints aren't met.
+type MessageRevisionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MessageRevisionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MessageRevisionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MessageRevisionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MessageRevisionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MessageRevisionValidationError) ErrorName() string { return "MessageRevisionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MessageRevisionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMessageRevision.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MessageRevisionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MessageRevisionValidationError{}
+
+// Validate checks the field values on GetMessageRevisionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMessageRevisionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMessageRevisionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMessageRevisionsResponseMultiError, or nil if none found.
+func (m *GetMessageRevisionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMessageRevisionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetChat()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetMessageRevisionsResponseValidationError{
+					field:  "Chat",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetMessageRevisionsResponseValidationError{
+					field:  "Chat",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetChat()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetMessageRevisionsResponseValidationError{
+				field:  "Chat",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
+	// no validation rules for CurrentMessage
+
+	for idx, item := range m.GetRevisions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetMessageRevisionsResponseValidationError{
+						field:  fmt.Sprintf("Revisions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetMessageRevisionsResponseValidationError{
+						field:  fmt.Sprintf("Revisions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetMessageRevisionsResponseValidationError{
+					field:  fmt.Sprintf("Revisions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetMessageRevisionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMessageRevisionsResponseMultiError is an error wrapping multiple
+// validation errors returned by GetMessageRevisionsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetMessageRevisionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMessageRevisionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMessageRevisionsResponseMultiError) AllErrors() []error { return m }
+
+// GetMessageRevisionsResponseValidationError is the validation error returned
+// by GetMessageRevisionsResponse.Validate if the designated constraints
+// aren't met.
+type GetMessageRevisionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMessageRevisionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMessageRevisionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMessageRevisionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMessageRevisionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMessageRevisionsResponseValidationError) ErrorName() string {
+	return "GetMessageRevisionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMessageRevisionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMessageRevisionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMessageRevisionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMessageRevisionsResponseValidationError{}
+
+// Validate checks the field values on PurgeChatRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PurgeChatRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PurgeChatRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PurgeChatRequestMultiError, or nil if none found.
+func (m *PurgeChatRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PurgeChatRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
 	if m.GetGroupId() <= 0 {
-		err := UpdateRoleUserRequestValidationError{
+		err := PurgeChatRequestValidationError{
 			field:  "GroupId",
 			reason: "value must be greater than 0",
 		}
@@ -1076,10 +1441,10 @@ func (m *UpdateRoleUserRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetRole()) < 1 {
-		err := UpdateRoleUserRequestValidationError{
-			field:  "Role",
-			reason: "value length must be at least 1 runes",
+	if m.GetChatId() <= 0 {
+		err := PurgeChatRequestValidationError{
+			field:  "ChatId",
+			reason: "value must be greater than 0",
 		}
 		if !all {
 			return err
@@ -1088,19 +1453,19 @@ func (m *UpdateRoleUserRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return UpdateRoleUserRequestMultiError(errors)
+		return PurgeChatRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateRoleUserRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateRoleUserRequest.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateRoleUserRequestMultiError []error
+// PurgeChatRequestMultiError is an error wrapping multiple validation errors
+// returned by PurgeChatRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PurgeChatRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateRoleUserRequestMultiError) Error() string {
+func (m PurgeChatRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1109,11 +1474,7889 @@ func (m UpdateRoleUserRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateRoleUserRequestMultiError) AllErrors() []error { return m }
+func (m PurgeChatRequestMultiError) AllErrors() []error { return m }
 
-// UpdateRoleUserRequestValidationError is the validation error returned by
-// UpdateRoleUserRequest.Validate if the designated constraints aren't met.
-type UpdateRoleUserRequestValidationError struct {
+// PurgeChatRequestValidationError is the validation error returned by
+// PurgeChatRequest.Validate if the designated constraints aren't met.
+type PurgeChatRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PurgeChatRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PurgeChatRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PurgeChatRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PurgeChatRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PurgeChatRequestValidationError) ErrorName() string { return "PurgeChatRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PurgeChatRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPurgeChatRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PurgeChatRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PurgeChatRequestValidationError{}
+
+// Validate checks the field values on UpdateChatRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateChatRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateChatRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateChatRequestMultiError, or nil if none found.
+func (m *UpdateChatRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateChatRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGroupId() <= 0 {
+		err := UpdateChatRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetChatId() <= 0 {
+		err := UpdateChatRequestValidationError{
+			field:  "ChatId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetMessage()) < 1 {
+		err := UpdateChatRequestValidationError{
+			field:  "Message",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateChatRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateChatRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateChatRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateChatRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateChatRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateChatRequestMultiError) AllErrors() []error { return m }
+
+// UpdateChatRequestValidationError is the validation error returned by
+// UpdateChatRequest.Validate if the designated constraints aren't met.
+type UpdateChatRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateChatRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateChatRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateChatRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateChatRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateChatRequestValidationError) ErrorName() string {
+	return "UpdateChatRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateChatRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateChatRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateChatRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateChatRequestValidationError{}
+
+// Validate checks the field values on CreateGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateGroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateGroupRequestMultiError, or nil if none found.
+func (m *CreateGroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateGroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := CreateGroupRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDesc()) < 1 {
+		err := CreateGroupRequestValidationError{
+			field:  "Desc",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreateGroupRequest_Visibility_InLookup[m.GetVisibility()]; !ok {
+		err := CreateGroupRequestValidationError{
+			field:  "Visibility",
+			reason: "value must be in list [ private public]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateGroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateGroupRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateGroupRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateGroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateGroupRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateGroupRequestMultiError) AllErrors() []error { return m }
+
+// CreateGroupRequestValidationError is the validation error returned by
+// CreateGroupRequest.Validate if the designated constraints aren't met.
+type CreateGroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateGroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateGroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateGroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateGroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateGroupRequestValidationError) ErrorName() string {
+	return "CreateGroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateGroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateGroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateGroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateGroupRequestValidationError{}
+
+var _CreateGroupRequest_Visibility_InLookup = map[string]struct{}{
+	"":        {},
+	"private": {},
+	"public":  {},
+}
+
+// Validate checks the field values on DeleteGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteGroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteGroupRequestMultiError, or nil if none found.
+func (m *DeleteGroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteGroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGroupId() <= 0 {
+		err := DeleteGroupRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteGroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteGroupRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteGroupRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteGroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteGroupRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteGroupRequestMultiError) AllErrors() []error { return m }
+
+// DeleteGroupRequestValidationError is the validation error returned by
+// DeleteGroupRequest.Validate if the designated constraints aren't met.
+type DeleteGroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteGroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteGroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteGroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteGroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteGroupRequestValidationError) ErrorName() string {
+	return "DeleteGroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteGroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteGroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteGroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteGroupRequestValidationError{}
+
+// Validate checks the field values on UpdateGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateGroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateGroupRequestMultiError, or nil if none found.
+func (m *UpdateGroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateGroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGroupId() <= 0 {
+		err := UpdateGroupRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := UpdateGroupRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDesc()) < 1 {
+		err := UpdateGroupRequestValidationError{
+			field:  "Desc",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.MessageTtlSeconds != nil {
+
+		if val := m.GetMessageTtlSeconds(); val < 0 || val > 31536000 {
+			err := UpdateGroupRequestValidationError{
+				field:  "MessageTtlSeconds",
+				reason: "value must be inside range [0, 31536000]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Visibility != nil {
+
+		if _, ok := _UpdateGroupRequest_Visibility_InLookup[m.GetVisibility()]; !ok {
+			err := UpdateGroupRequestValidationError{
+				field:  "Visibility",
+				reason: "value must be in list [private public]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateGroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateGroupRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateGroupRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateGroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateGroupRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateGroupRequestMultiError) AllErrors() []error { return m }
+
+// UpdateGroupRequestValidationError is the validation error returned by
+// UpdateGroupRequest.Validate if the designated constraints aren't met.
+type UpdateGroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateGroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateGroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateGroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateGroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateGroupRequestValidationError) ErrorName() string {
+	return "UpdateGroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateGroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateGroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateGroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateGroupRequestValidationError{}
+
+var _UpdateGroupRequest_Visibility_InLookup = map[string]struct{}{
+	"private": {},
+	"public":  {},
+}
+
+// Validate checks the field values on UpdateRoleUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateRoleUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateRoleUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateRoleUserRequestMultiError, or nil if none found.
+func (m *UpdateRoleUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateRoleUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetMemberId() <= 0 {
+		err := UpdateRoleUserRequestValidationError{
+			field:  "MemberId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetGroupId() <= 0 {
+		err := UpdateRoleUserRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetRole()) < 1 {
+		err := UpdateRoleUserRequestValidationError{
+			field:  "Role",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateRoleUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateRoleUserRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateRoleUserRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateRoleUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateRoleUserRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateRoleUserRequestMultiError) AllErrors() []error { return m }
+
+// UpdateRoleUserRequestValidationError is the validation error returned by
+// UpdateRoleUserRequest.Validate if the designated constraints aren't met.
+type UpdateRoleUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateRoleUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateRoleUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateRoleUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateRoleUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateRoleUserRequestValidationError) ErrorName() string {
+	return "UpdateRoleUserRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateRoleUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateRoleUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateRoleUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateRoleUserRequestValidationError{}
+
+// Validate checks the field values on AddMemberRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AddMemberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddMemberRequestMultiError, or nil if none found.
+func (m *AddMemberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddMemberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGroupId() <= 0 {
+		err := AddMemberRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetListUserId() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddMemberRequestValidationError{
+						field:  fmt.Sprintf("ListUserId[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddMemberRequestValidationError{
+						field:  fmt.Sprintf("ListUserId[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddMemberRequestValidationError{
+					field:  fmt.Sprintf("ListUserId[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AddMemberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddMemberRequestMultiError is an error wrapping multiple validation errors
+// returned by AddMemberRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AddMemberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddMemberRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddMemberRequestMultiError) AllErrors() []error { return m }
+
+// AddMemberRequestValidationError is the validation error returned by
+// AddMemberRequest.Validate if the designated constraints aren't met.
+type AddMemberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddMemberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddMemberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddMemberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddMemberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddMemberRequestValidationError) ErrorName() string { return "AddMemberRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AddMemberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddMemberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddMemberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddMemberRequestValidationError{}
+
+// Validate checks the field values on RemoveMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveMemberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveMemberRequestMultiError, or nil if none found.
+func (m *RemoveMemberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveMemberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGroupId() <= 0 {
+		err := RemoveMemberRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetListMemberId() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RemoveMemberRequestValidationError{
+						field:  fmt.Sprintf("ListMemberId[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RemoveMemberRequestValidationError{
+						field:  fmt.Sprintf("ListMemberId[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RemoveMemberRequestValidationError{
+					field:  fmt.Sprintf("ListMemberId[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RemoveMemberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveMemberRequestMultiError is an error wrapping multiple validation
+// errors returned by RemoveMemberRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveMemberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveMemberRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveMemberRequestMultiError) AllErrors() []error { return m }
+
+// RemoveMemberRequestValidationError is the validation error returned by
+// RemoveMemberRequest.Validate if the designated constraints aren't met.
+type RemoveMemberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveMemberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveMemberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveMemberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveMemberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveMemberRequestValidationError) ErrorName() string {
+	return "RemoveMemberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveMemberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveMemberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveMemberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveMemberRequestValidationError{}
+
+// Validate checks the field values on ListUserId with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ListUserId) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUserId with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ListUserIdMultiError, or
+// nil if none found.
+func (m *ListUserId) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUserId) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := ListUserIdValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListUserIdMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUserIdMultiError is an error wrapping multiple validation errors
+// returned by ListUserId.ValidateAll() if the designated constraints aren't met.
+type ListUserIdMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUserIdMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUserIdMultiError) AllErrors() []error { return m }
+
+// ListUserIdValidationError is the validation error returned by
+// ListUserId.Validate if the designated constraints aren't met.
+type ListUserIdValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUserIdValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUserIdValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUserIdValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUserIdValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUserIdValidationError) ErrorName() string { return "ListUserIdValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListUserIdValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUserId.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUserIdValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUserIdValidationError{}
+
+// Validate checks the field values on ExitGroupRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ExitGroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExitGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExitGroupRequestMultiError, or nil if none found.
+func (m *ExitGroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExitGroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGroupId() <= 0 {
+		err := ExitGroupRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ExitGroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExitGroupRequestMultiError is an error wrapping multiple validation errors
+// returned by ExitGroupRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ExitGroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExitGroupRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExitGroupRequestMultiError) AllErrors() []error { return m }
+
+// ExitGroupRequestValidationError is the validation error returned by
+// ExitGroupRequest.Validate if the designated constraints aren't met.
+type ExitGroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExitGroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExitGroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExitGroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExitGroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExitGroupRequestValidationError) ErrorName() string { return "ExitGroupRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExitGroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExitGroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExitGroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExitGroupRequestValidationError{}
+
+// Validate checks the field values on StatusResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StatusResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StatusResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StatusResponseMultiError,
+// or nil if none found.
+func (m *StatusResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StatusResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return StatusResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StatusResponseMultiError is an error wrapping multiple validation errors
+// returned by StatusResponse.ValidateAll() if the designated constraints
+// aren't met.
+type StatusResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StatusResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StatusResponseMultiError) AllErrors() []error { return m }
+
+// StatusResponseValidationError is the validation error returned by
+// StatusResponse.Validate if the designated constraints aren't met.
+type StatusResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StatusResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StatusResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StatusResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StatusResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StatusResponseValidationError) ErrorName() string { return "StatusResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StatusResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStatusResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StatusResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StatusResponseValidationError{}
+
+// Validate checks the field values on ChatStreamingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ChatStreamingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChatStreamingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ChatStreamingRequestMultiError, or nil if none found.
+func (m *ChatStreamingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChatStreamingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGroupId() <= 0 {
+		err := ChatStreamingRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ThreadId
+
+	if len(errors) > 0 {
+		return ChatStreamingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChatStreamingRequestMultiError is an error wrapping multiple validation
+// errors returned by ChatStreamingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ChatStreamingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChatStreamingRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChatStreamingRequestMultiError) AllErrors() []error { return m }
+
+// ChatStreamingRequestValidationError is the validation error returned by
+// ChatStreamingRequest.Validate if the designated constraints aren't met.
+type ChatStreamingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChatStreamingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChatStreamingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChatStreamingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChatStreamingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChatStreamingRequestValidationError) ErrorName() string {
+	return "ChatStreamingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ChatStreamingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChatStreamingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChatStreamingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChatStreamingRequestValidationError{}
+
+// Validate checks the field values on ChatStreamingResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ChatStreamingResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChatStreamingResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ChatStreamingResponseMultiError, or nil if none found.
+func (m *ChatStreamingResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChatStreamingResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Member
+
+	// no validation rules for Username
+
+	// no validation rules for Message
+
+	// no validation rules for Timestamp
+
+	// no validation rules for Action
+
+	for idx, item := range m.GetReadStatus() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChatStreamingResponseValidationError{
+						field:  fmt.Sprintf("ReadStatus[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChatStreamingResponseValidationError{
+						field:  fmt.Sprintf("ReadStatus[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChatStreamingResponseValidationError{
+					field:  fmt.Sprintf("ReadStatus[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ChatId
+
+	// no validation rules for GroupId
+
+	for idx, item := range m.GetAttachments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChatStreamingResponseValidationError{
+						field:  fmt.Sprintf("Attachments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChatStreamingResponseValidationError{
+						field:  fmt.Sprintf("Attachments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChatStreamingResponseValidationError{
+					field:  fmt.Sprintf("Attachments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetReactions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChatStreamingResponseValidationError{
+						field:  fmt.Sprintf("Reactions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChatStreamingResponseValidationError{
+						field:  fmt.Sprintf("Reactions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChatStreamingResponseValidationError{
+					field:  fmt.Sprintf("Reactions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetReplyTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ChatStreamingResponseValidationError{
+					field:  "ReplyTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ChatStreamingResponseValidationError{
+					field:  "ReplyTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReplyTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ChatStreamingResponseValidationError{
+				field:  "ReplyTo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ThreadRootId
+
+	// no validation rules for ThreadReplyCount
+
+	// no validation rules for LastReplyAt
+
+	// no validation rules for Pinned
+
+	// no validation rules for PinnedBy
+
+	// no validation rules for PinnedAt
+
+	// no validation rules for Typing
+
+	// no validation rules for Deleted
+
+	// no validation rules for DeletedBy
+
+	// no validation rules for DeletedAt
+
+	// no validation rules for EditedAt
+
+	if all {
+		switch v := interface{}(m.GetForwardedFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ChatStreamingResponseValidationError{
+					field:  "ForwardedFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ChatStreamingResponseValidationError{
+					field:  "ForwardedFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetForwardedFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ChatStreamingResponseValidationError{
+				field:  "ForwardedFrom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ExpiresAt
+
+	if all {
+		switch v := interface{}(m.GetProfile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ChatStreamingResponseValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ChatStreamingResponseValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProfile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ChatStreamingResponseValidationError{
+				field:  "Profile",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SenderBlocked
+
+	if len(errors) > 0 {
+		return ChatStreamingResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChatStreamingResponseMultiError is an error wrapping multiple validation
+// errors returned by ChatStreamingResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ChatStreamingResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChatStreamingResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChatStreamingResponseMultiError) AllErrors() []error { return m }
+
+// ChatStreamingResponseValidationError is the validation error returned by
+// ChatStreamingResponse.Validate if the designated constraints aren't met.
+type ChatStreamingResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChatStreamingResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChatStreamingResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChatStreamingResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChatStreamingResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChatStreamingResponseValidationError) ErrorName() string {
+	return "ChatStreamingResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ChatStreamingResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChatStreamingResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChatStreamingResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChatStreamingResponseValidationError{}
+
+// Validate checks the field values on ForwardInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ForwardInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ForwardInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ForwardInfoMultiError, or
+// nil if none found.
+func (m *ForwardInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ForwardInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ChatId
+
+	// no validation rules for GroupId
+
+	// no validation rules for GroupName
+
+	// no validation rules for UserId
+
+	// no validation rules for Username
+
+	if len(errors) > 0 {
+		return ForwardInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// ForwardInfoMultiError is an error wrapping multiple validation errors
+// returned by ForwardInfo.ValidateAll() if the designated constraints aren't met.
+type ForwardInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ForwardInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ForwardInfoMultiError) AllErrors() []error { return m }
+
+// ForwardInfoValidationError is the validation error returned by
+// ForwardInfo.Validate if the designated constraints aren't met.
+type ForwardInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ForwardInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ForwardInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ForwardInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ForwardInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ForwardInfoValidationError) ErrorName() string { return "ForwardInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ForwardInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sForwardInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ForwardInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ForwardInfoValidationError{}
+
+// Validate checks the field values on ReplyPreview with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReplyPreview) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReplyPreview with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReplyPreviewMultiError, or
+// nil if none found.
+func (m *ReplyPreview) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReplyPreview) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ChatId
+
+	// no validation rules for Member
+
+	// no validation rules for Username
+
+	// no validation rules for Snippet
+
+	// no validation rules for Deleted
+
+	if len(errors) > 0 {
+		return ReplyPreviewMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReplyPreviewMultiError is an error wrapping multiple validation errors
+// returned by ReplyPreview.ValidateAll() if the designated constraints aren't met.
+type ReplyPreviewMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReplyPreviewMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReplyPreviewMultiError) AllErrors() []error { return m }
+
+// ReplyPreviewValidationError is the validation error returned by
+// ReplyPreview.Validate if the designated constraints aren't met.
+type ReplyPreviewValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplyPreviewValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplyPreviewValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplyPreviewValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplyPreviewValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplyPreviewValidationError) ErrorName() string { return "ReplyPreviewValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReplyPreviewValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplyPreview.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplyPreviewValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplyPreviewValidationError{}
+
+// Validate checks the field values on ReactionCount with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReactionCount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReactionCount with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReactionCountMultiError, or
+// nil if none found.
+func (m *ReactionCount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReactionCount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Emoji
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return ReactionCountMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReactionCountMultiError is an error wrapping multiple validation errors
+// returned by ReactionCount.ValidateAll() if the designated constraints
+// aren't met.
+type ReactionCountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReactionCountMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReactionCountMultiError) AllErrors() []error { return m }
+
+// ReactionCountValidationError is the validation error returned by
+// ReactionCount.Validate if the designated constraints aren't met.
+type ReactionCountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReactionCountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReactionCountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReactionCountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReactionCountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReactionCountValidationError) ErrorName() string { return "ReactionCountValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReactionCountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReactionCount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReactionCountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReactionCountValidationError{}
+
+// Validate checks the field values on StatusStreamingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StatusStreamingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StatusStreamingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StatusStreamingRequestMultiError, or nil if none found.
+func (m *StatusStreamingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StatusStreamingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGroupId() <= 0 {
+		err := StatusStreamingRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return StatusStreamingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StatusStreamingRequestMultiError is an error wrapping multiple validation
+// errors returned by StatusStreamingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type StatusStreamingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StatusStreamingRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StatusStreamingRequestMultiError) AllErrors() []error { return m }
+
+// StatusStreamingRequestValidationError is the validation error returned by
+// StatusStreamingRequest.Validate if the designated constraints aren't met.
+type StatusStreamingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StatusStreamingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StatusStreamingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StatusStreamingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StatusStreamingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StatusStreamingRequestValidationError) ErrorName() string {
+	return "StatusStreamingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StatusStreamingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStatusStreamingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StatusStreamingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StatusStreamingRequestValidationError{}
+
+// Validate checks the field values on StatusStreamingResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StatusStreamingResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StatusStreamingResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StatusStreamingResponseMultiError, or nil if none found.
+func (m *StatusStreamingResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StatusStreamingResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Member
+
+	// no validation rules for Username
+
+	// no validation rules for Status
+
+	// no validation rules for Typing
+
+	// no validation rules for Event
+
+	if all {
+		switch v := interface{}(m.GetJoinRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StatusStreamingResponseValidationError{
+					field:  "JoinRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StatusStreamingResponseValidationError{
+					field:  "JoinRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJoinRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StatusStreamingResponseValidationError{
+				field:  "JoinRequest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetProfile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StatusStreamingResponseValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StatusStreamingResponseValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProfile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StatusStreamingResponseValidationError{
+				field:  "Profile",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StatusStreamingResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StatusStreamingResponseMultiError is an error wrapping multiple validation
+// errors returned by StatusStreamingResponse.ValidateAll() if the designated
+// constraints aren't met.
+type StatusStreamingResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StatusStreamingResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StatusStreamingResponseMultiError) AllErrors() []error { return m }
+
+// StatusStreamingResponseValidationError is the validation error returned by
+// StatusStreamingResponse.Validate if the designated constraints aren't met.
+type StatusStreamingResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StatusStreamingResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StatusStreamingResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StatusStreamingResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StatusStreamingResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StatusStreamingResponseValidationError) ErrorName() string {
+	return "StatusStreamingResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StatusStreamingResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStatusStreamingResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StatusStreamingResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StatusStreamingResponseValidationError{}
+
+// Validate checks the field values on SetTypingRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SetTypingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetTypingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetTypingRequestMultiError, or nil if none found.
+func (m *SetTypingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetTypingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGroupId() <= 0 {
+		err := SetTypingRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Typing
+
+	if len(errors) > 0 {
+		return SetTypingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetTypingRequestMultiError is an error wrapping multiple validation errors
+// returned by SetTypingRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SetTypingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetTypingRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetTypingRequestMultiError) AllErrors() []error { return m }
+
+// SetTypingRequestValidationError is the validation error returned by
+// SetTypingRequest.Validate if the designated constraints aren't met.
+type SetTypingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetTypingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetTypingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetTypingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetTypingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetTypingRequestValidationError) ErrorName() string { return "SetTypingRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SetTypingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetTypingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetTypingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetTypingRequestValidationError{}
+
+// Validate checks the field values on GetListGroupResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetListGroupResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetListGroupResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetListGroupResponseMultiError, or nil if none found.
+func (m *GetListGroupResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetListGroupResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetGroup() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetListGroupResponseValidationError{
+						field:  fmt.Sprintf("Group[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetListGroupResponseValidationError{
+						field:  fmt.Sprintf("Group[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetListGroupResponseValidationError{
+					field:  fmt.Sprintf("Group[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetListGroupResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetListGroupResponseMultiError is an error wrapping multiple validation
+// errors returned by GetListGroupResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetListGroupResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetListGroupResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetListGroupResponseMultiError) AllErrors() []error { return m }
+
+// GetListGroupResponseValidationError is the validation error returned by
+// GetListGroupResponse.Validate if the designated constraints aren't met.
+type GetListGroupResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetListGroupResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetListGroupResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetListGroupResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetListGroupResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetListGroupResponseValidationError) ErrorName() string {
+	return "GetListGroupResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetListGroupResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetListGroupResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetListGroupResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetListGroupResponseValidationError{}
+
+// Validate checks the field values on GroupInfo with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GroupInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GroupInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GroupInfoMultiError, or nil
+// if none found.
+func (m *GroupInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GroupInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for LastMessage
+
+	// no validation rules for UnreadCount
+
+	// no validation rules for UnreadMentionCount
+
+	// no validation rules for MessageTtlSeconds
+
+	// no validation rules for Visibility
+
+	if len(errors) > 0 {
+		return GroupInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// GroupInfoMultiError is an error wrapping multiple validation errors returned
+// by GroupInfo.ValidateAll() if the designated constraints aren't met.
+type GroupInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GroupInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GroupInfoMultiError) AllErrors() []error { return m }
+
+// GroupInfoValidationError is the validation error returned by
+// GroupInfo.Validate if the designated constraints aren't met.
+type GroupInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GroupInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GroupInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GroupInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GroupInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GroupInfoValidationError) ErrorName() string { return "GroupInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GroupInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGroupInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GroupInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GroupInfoValidationError{}
+
+// Validate checks the field values on Attachment with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Attachment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Attachment with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AttachmentMultiError, or
+// nil if none found.
+func (m *Attachment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Attachment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for FileName
+
+	// no validation rules for ContentType
+
+	// no validation rules for Size
+
+	// no validation rules for Sha256
+
+	// no validation rules for Width
+
+	// no validation rules for Height
+
+	// no validation rules for Blurhash
+
+	// no validation rules for Status
+
+	for idx, item := range m.GetThumbnails() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AttachmentValidationError{
+						field:  fmt.Sprintf("Thumbnails[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AttachmentValidationError{
+						field:  fmt.Sprintf("Thumbnails[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AttachmentValidationError{
+					field:  fmt.Sprintf("Thumbnails[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AttachmentMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttachmentMultiError is an error wrapping multiple validation errors
+// returned by Attachment.ValidateAll() if the designated constraints aren't met.
+type AttachmentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttachmentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttachmentMultiError) AllErrors() []error { return m }
+
+// AttachmentValidationError is the validation error returned by
+// Attachment.Validate if the designated constraints aren't met.
+type AttachmentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttachmentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttachmentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttachmentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttachmentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttachmentValidationError) ErrorName() string { return "AttachmentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AttachmentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttachment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttachmentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttachmentValidationError{}
+
+// Validate checks the field values on Thumbnail with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Thumbnail) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Thumbnail with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ThumbnailMultiError, or nil
+// if none found.
+func (m *Thumbnail) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Thumbnail) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Size
+
+	// no validation rules for Width
+
+	// no validation rules for Height
+
+	if len(errors) > 0 {
+		return ThumbnailMultiError(errors)
+	}
+
+	return nil
+}
+
+// ThumbnailMultiError is an error wrapping multiple validation errors returned
+// by Thumbnail.ValidateAll() if the designated constraints aren't met.
+type ThumbnailMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ThumbnailMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ThumbnailMultiError) AllErrors() []error { return m }
+
+// ThumbnailValidationError is the validation error returned by
+// Thumbnail.Validate if the designated constraints aren't met.
+type ThumbnailValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ThumbnailValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ThumbnailValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ThumbnailValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ThumbnailValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ThumbnailValidationError) ErrorName() string { return "ThumbnailValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ThumbnailValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sThumbnail.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ThumbnailValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ThumbnailValidationError{}
+
+// Validate checks the field values on AttachmentInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AttachmentInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AttachmentInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AttachmentInfoMultiError,
+// or nil if none found.
+func (m *AttachmentInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttachmentInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGroupId() <= 0 {
+		err := AttachmentInfoValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetFileName()); l < 1 || l > 255 {
+		err := AttachmentInfoValidationError{
+			field:  "FileName",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetContentType()); l < 3 || l > 127 {
+		err := AttachmentInfoValidationError{
+			field:  "ContentType",
+			reason: "value length must be between 3 and 127 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AttachmentInfo_ContentType_Pattern.MatchString(m.GetContentType()) {
+		err := AttachmentInfoValidationError{
+			field:  "ContentType",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9.+-]+/[a-zA-Z0-9.+-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSize() <= 0 {
+		err := AttachmentInfoValidationError{
+			field:  "Size",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AttachmentInfo_Sha256_Pattern.MatchString(m.GetSha256()) {
+		err := AttachmentInfoValidationError{
+			field:  "Sha256",
+			reason: "value does not match regex pattern \"^[a-f0-9]{64}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AttachmentInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttachmentInfoMultiError is an error wrapping multiple validation errors
+// returned by AttachmentInfo.ValidateAll() if the designated constraints
+// aren't met.
+type AttachmentInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttachmentInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttachmentInfoMultiError) AllErrors() []error { return m }
+
+// AttachmentInfoValidationError is the validation error returned by
+// AttachmentInfo.Validate if the designated constraints aren't met.
+type AttachmentInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttachmentInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttachmentInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttachmentInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttachmentInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttachmentInfoValidationError) ErrorName() string { return "AttachmentInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AttachmentInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttachmentInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttachmentInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttachmentInfoValidationError{}
+
+var _AttachmentInfo_ContentType_Pattern = regexp.MustCompile("^[a-zA-Z0-9.+-]+/[a-zA-Z0-9.+-]+$")
+
+var _AttachmentInfo_Sha256_Pattern = regexp.MustCompile("^[a-f0-9]{64}$")
+
+// Validate checks the field values on UploadAttachmentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadAttachmentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadAttachmentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadAttachmentRequestMultiError, or nil if none found.
+func (m *UploadAttachmentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadAttachmentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	oneofDataPresent := false
+	switch v := m.Data.(type) {
+	case *UploadAttachmentRequest_Info:
+		if v == nil {
+			err := UploadAttachmentRequestValidationError{
+				field:  "Data",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofDataPresent = true
+
+		if all {
+			switch v := interface{}(m.GetInfo()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UploadAttachmentRequestValidationError{
+						field:  "Info",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UploadAttachmentRequestValidationError{
+						field:  "Info",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetInfo()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UploadAttachmentRequestValidationError{
+					field:  "Info",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *UploadAttachmentRequest_Chunk:
+		if v == nil {
+			err := UploadAttachmentRequestValidationError{
+				field:  "Data",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofDataPresent = true
+
+		if l := len(m.GetChunk()); l < 1 || l > 1048576 {
+			err := UploadAttachmentRequestValidationError{
+				field:  "Chunk",
+				reason: "value length must be between 1 and 1048576 bytes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofDataPresent {
+		err := UploadAttachmentRequestValidationError{
+			field:  "Data",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UploadAttachmentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadAttachmentRequestMultiError is an error wrapping multiple validation
+// errors returned by UploadAttachmentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UploadAttachmentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadAttachmentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadAttachmentRequestMultiError) AllErrors() []error { return m }
+
+// UploadAttachmentRequestValidationError is the validation error returned by
+// UploadAttachmentRequest.Validate if the designated constraints aren't met.
+type UploadAttachmentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadAttachmentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadAttachmentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadAttachmentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadAttachmentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadAttachmentRequestValidationError) ErrorName() string {
+	return "UploadAttachmentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadAttachmentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadAttachmentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadAttachmentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadAttachmentRequestValidationError{}
+
+// Validate checks the field values on UploadAttachmentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadAttachmentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadAttachmentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadAttachmentResponseMultiError, or nil if none found.
+func (m *UploadAttachmentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadAttachmentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAttachment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UploadAttachmentResponseValidationError{
+					field:  "Attachment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UploadAttachmentResponseValidationError{
+					field:  "Attachment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAttachment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UploadAttachmentResponseValidationError{
+				field:  "Attachment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UploadAttachmentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadAttachmentResponseMultiError is an error wrapping multiple validation
+// errors returned by UploadAttachmentResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UploadAttachmentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadAttachmentResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadAttachmentResponseMultiError) AllErrors() []error { return m }
+
+// UploadAttachmentResponseValidationError is the validation error returned by
+// UploadAttachmentResponse.Validate if the designated constraints aren't met.
+type UploadAttachmentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadAttachmentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadAttachmentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadAttachmentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadAttachmentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadAttachmentResponseValidationError) ErrorName() string {
+	return "UploadAttachmentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadAttachmentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadAttachmentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadAttachmentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadAttachmentResponseValidationError{}
+
+// Validate checks the field values on DownloadAttachmentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DownloadAttachmentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadAttachmentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DownloadAttachmentRequestMultiError, or nil if none found.
+func (m *DownloadAttachmentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadAttachmentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAttachmentId() <= 0 {
+		err := DownloadAttachmentRequestValidationError{
+			field:  "AttachmentId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetThumbnailSize() < 0 {
+		err := DownloadAttachmentRequestValidationError{
+			field:  "ThumbnailSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DownloadAttachmentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DownloadAttachmentRequestMultiError is an error wrapping multiple validation
+// errors returned by DownloadAttachmentRequest.ValidateAll() if the
+// designated constraints aren't met.
+type DownloadAttachmentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DownloadAttachmentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DownloadAttachmentRequestMultiError) AllErrors() []error { return m }
+
+// DownloadAttachmentRequestValidationError is the validation error returned by
+// DownloadAttachmentRequest.Validate if the designated constraints aren't met.
+type DownloadAttachmentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadAttachmentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadAttachmentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadAttachmentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadAttachmentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadAttachmentRequestValidationError) ErrorName() string {
+	return "DownloadAttachmentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DownloadAttachmentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadAttachmentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadAttachmentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadAttachmentRequestValidationError{}
+
+// Validate checks the field values on DownloadAttachmentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DownloadAttachmentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadAttachmentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DownloadAttachmentResponseMultiError, or nil if none found.
+func (m *DownloadAttachmentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadAttachmentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Data.(type) {
+	case *DownloadAttachmentResponse_Info:
+		if v == nil {
+			err := DownloadAttachmentResponseValidationError{
+				field:  "Data",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetInfo()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DownloadAttachmentResponseValidationError{
+						field:  "Info",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DownloadAttachmentResponseValidationError{
+						field:  "Info",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetInfo()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DownloadAttachmentResponseValidationError{
+					field:  "Info",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *DownloadAttachmentResponse_Chunk:
+		if v == nil {
+			err := DownloadAttachmentResponseValidationError{
+				field:  "Data",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Chunk
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return DownloadAttachmentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DownloadAttachmentResponseMultiError is an error wrapping multiple
+// validation errors returned by DownloadAttachmentResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DownloadAttachmentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DownloadAttachmentResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DownloadAttachmentResponseMultiError) AllErrors() []error { return m }
+
+// DownloadAttachmentResponseValidationError is the validation error returned
+// by DownloadAttachmentResponse.Validate if the designated constraints aren't met.
+type DownloadAttachmentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadAttachmentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadAttachmentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadAttachmentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadAttachmentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadAttachmentResponseValidationError) ErrorName() string {
+	return "DownloadAttachmentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DownloadAttachmentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadAttachmentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadAttachmentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadAttachmentResponseValidationError{}
+
+// Validate checks the field values on AddReactionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddReactionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddReactionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddReactionRequestMultiError, or nil if none found.
+func (m *AddReactionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddReactionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGroupId() <= 0 {
+		err := AddReactionRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetChatId() <= 0 {
+		err := AddReactionRequestValidationError{
+			field:  "ChatId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetEmoji()) < 1 {
+		err := AddReactionRequestValidationError{
+			field:  "Emoji",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetEmoji()) > 32 {
+		err := AddReactionRequestValidationError{
+			field:  "Emoji",
+			reason: "value length must be at most 32 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AddReactionRequest_Emoji_Pattern.MatchString(m.GetEmoji()) {
+		err := AddReactionRequestValidationError{
+			field:  "Emoji",
+			reason: "value does not match regex pattern \"^\\\\S+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AddReactionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddReactionRequestMultiError is an error wrapping multiple validation errors
+// returned by AddReactionRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AddReactionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddReactionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddReactionRequestMultiError) AllErrors() []error { return m }
+
+// AddReactionRequestValidationError is the validation error returned by
+// AddReactionRequest.Validate if the designated constraints aren't met.
+type AddReactionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddReactionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddReactionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddReactionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddReactionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddReactionRequestValidationError) ErrorName() string {
+	return "AddReactionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddReactionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddReactionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddReactionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddReactionRequestValidationError{}
+
+var _AddReactionRequest_Emoji_Pattern = regexp.MustCompile("^\\S+$")
+
+// Validate checks the field values on RemoveReactionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveReactionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveReactionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveReactionRequestMultiError, or nil if none found.
+func (m *RemoveReactionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveReactionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGroupId() <= 0 {
+		err := RemoveReactionRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetChatId() <= 0 {
+		err := RemoveReactionRequestValidationError{
+			field:  "ChatId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetEmoji()) < 1 {
+		err := RemoveReactionRequestValidationError{
+			field:  "Emoji",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetEmoji()) > 32 {
+		err := RemoveReactionRequestValidationError{
+			field:  "Emoji",
+			reason: "value length must be at most 32 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RemoveReactionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveReactionRequestMultiError is an error wrapping multiple validation
+// errors returned by RemoveReactionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveReactionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveReactionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveReactionRequestMultiError) AllErrors() []error { return m }
+
+// RemoveReactionRequestValidationError is the validation error returned by
+// RemoveReactionRequest.Validate if the designated constraints aren't met.
+type RemoveReactionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveReactionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveReactionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveReactionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveReactionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveReactionRequestValidationError) ErrorName() string {
+	return "RemoveReactionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveReactionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveReactionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveReactionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveReactionRequestValidationError{}
+
+// Validate checks the field values on ListThreadRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListThreadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListThreadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListThreadRequestMultiError, or nil if none found.
+func (m *ListThreadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListThreadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGroupId() <= 0 {
+		err := ListThreadRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetChatId() <= 0 {
+		err := ListThreadRequestValidationError{
+			field:  "ChatId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListThreadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListThreadRequestMultiError is an error wrapping multiple validation errors
+// returned by ListThreadRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListThreadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListThreadRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListThreadRequestMultiError) AllErrors() []error { return m }
+
+// ListThreadRequestValidationError is the validation error returned by
+// ListThreadRequest.Validate if the designated constraints aren't met.
+type ListThreadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListThreadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListThreadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListThreadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListThreadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListThreadRequestValidationError) ErrorName() string {
+	return "ListThreadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListThreadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListThreadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListThreadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListThreadRequestValidationError{}
+
+// Validate checks the field values on ListThreadResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListThreadResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListThreadResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListThreadResponseMultiError, or nil if none found.
+func (m *ListThreadResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListThreadResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRoot()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListThreadResponseValidationError{
+					field:  "Root",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListThreadResponseValidationError{
+					field:  "Root",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRoot()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListThreadResponseValidationError{
+				field:  "Root",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetReplies() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListThreadResponseValidationError{
+						field:  fmt.Sprintf("Replies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListThreadResponseValidationError{
+						field:  fmt.Sprintf("Replies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListThreadResponseValidationError{
+					field:  fmt.Sprintf("Replies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListThreadResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListThreadResponseMultiError is an error wrapping multiple validation errors
+// returned by ListThreadResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListThreadResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListThreadResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListThreadResponseMultiError) AllErrors() []error { return m }
+
+// ListThreadResponseValidationError is the validation error returned by
+// ListThreadResponse.Validate if the designated constraints aren't met.
+type ListThreadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListThreadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListThreadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListThreadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListThreadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListThreadResponseValidationError) ErrorName() string {
+	return "ListThreadResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListThreadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListThreadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListThreadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListThreadResponseValidationError{}
+
+// Validate checks the field values on ListMentionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMentionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMentionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMentionsRequestMultiError, or nil if none found.
+func (m *ListMentionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMentionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for GroupId
+
+	// no validation rules for UnreadOnly
+
+	if val := m.GetLimit(); val < 0 || val > 100 {
+		err := ListMentionsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListMentionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMentionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListMentionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListMentionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMentionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMentionsRequestMultiError) AllErrors() []error { return m }
+
+// ListMentionsRequestValidationError is the validation error returned by
+// ListMentionsRequest.Validate if the designated constraints aren't met.
+type ListMentionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMentionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMentionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMentionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMentionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMentionsRequestValidationError) ErrorName() string {
+	return "ListMentionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMentionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMentionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMentionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMentionsRequestValidationError{}
+
+// Validate checks the field values on MentionInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MentionInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MentionInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MentionInfoMultiError, or
+// nil if none found.
+func (m *MentionInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MentionInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for GroupId
+
+	// no validation rules for IsRead
+
+	// no validation rules for CreatedAt
+
+	if all {
+		switch v := interface{}(m.GetChat()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MentionInfoValidationError{
+					field:  "Chat",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MentionInfoValidationError{
+					field:  "Chat",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetChat()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MentionInfoValidationError{
+				field:  "Chat",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MentionInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// MentionInfoMultiError is an error wrapping multiple validation errors
+// returned by MentionInfo.ValidateAll() if the designated constraints aren't met.
+type MentionInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MentionInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MentionInfoMultiError) AllErrors() []error { return m }
+
+// MentionInfoValidationError is the validation error returned by
+// MentionInfo.Validate if the designated constraints aren't met.
+type MentionInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MentionInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MentionInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MentionInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MentionInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MentionInfoValidationError) ErrorName() string { return "MentionInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MentionInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMentionInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MentionInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MentionInfoValidationError{}
+
+// Validate checks the field values on ListMentionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMentionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMentionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMentionsResponseMultiError, or nil if none found.
+func (m *ListMentionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMentionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMentions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListMentionsResponseValidationError{
+						field:  fmt.Sprintf("Mentions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListMentionsResponseValidationError{
+						field:  fmt.Sprintf("Mentions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListMentionsResponseValidationError{
+					field:  fmt.Sprintf("Mentions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListMentionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMentionsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListMentionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListMentionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMentionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMentionsResponseMultiError) AllErrors() []error { return m }
+
+// ListMentionsResponseValidationError is the validation error returned by
+// ListMentionsResponse.Validate if the designated constraints aren't met.
+type ListMentionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMentionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMentionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMentionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMentionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMentionsResponseValidationError) ErrorName() string {
+	return "ListMentionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMentionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMentionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMentionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMentionsResponseValidationError{}
+
+// Validate checks the field values on PinMessageRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PinMessageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PinMessageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PinMessageRequestMultiError, or nil if none found.
+func (m *PinMessageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PinMessageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGroupId() <= 0 {
+		err := PinMessageRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetChatId() <= 0 {
+		err := PinMessageRequestValidationError{
+			field:  "ChatId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PinMessageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PinMessageRequestMultiError is an error wrapping multiple validation errors
+// returned by PinMessageRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PinMessageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PinMessageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PinMessageRequestMultiError) AllErrors() []error { return m }
+
+// PinMessageRequestValidationError is the validation error returned by
+// PinMessageRequest.Validate if the designated constraints aren't met.
+type PinMessageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PinMessageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PinMessageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PinMessageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PinMessageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PinMessageRequestValidationError) ErrorName() string {
+	return "PinMessageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PinMessageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPinMessageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PinMessageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PinMessageRequestValidationError{}
+
+// Validate checks the field values on UnpinMessageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnpinMessageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnpinMessageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnpinMessageRequestMultiError, or nil if none found.
+func (m *UnpinMessageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnpinMessageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGroupId() <= 0 {
+		err := UnpinMessageRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetChatId() <= 0 {
+		err := UnpinMessageRequestValidationError{
+			field:  "ChatId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UnpinMessageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnpinMessageRequestMultiError is an error wrapping multiple validation
+// errors returned by UnpinMessageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UnpinMessageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnpinMessageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnpinMessageRequestMultiError) AllErrors() []error { return m }
+
+// UnpinMessageRequestValidationError is the validation error returned by
+// UnpinMessageRequest.Validate if the designated constraints aren't met.
+type UnpinMessageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnpinMessageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnpinMessageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnpinMessageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnpinMessageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnpinMessageRequestValidationError) ErrorName() string {
+	return "UnpinMessageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnpinMessageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnpinMessageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnpinMessageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnpinMessageRequestValidationError{}
+
+// Validate checks the field values on ListPinnedMessagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPinnedMessagesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPinnedMessagesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPinnedMessagesRequestMultiError, or nil if none found.
+func (m *ListPinnedMessagesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPinnedMessagesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGroupId() <= 0 {
+		err := ListPinnedMessagesRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListPinnedMessagesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPinnedMessagesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListPinnedMessagesRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListPinnedMessagesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPinnedMessagesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPinnedMessagesRequestMultiError) AllErrors() []error { return m }
+
+// ListPinnedMessagesRequestValidationError is the validation error returned by
+// ListPinnedMessagesRequest.Validate if the designated constraints aren't met.
+type ListPinnedMessagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPinnedMessagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPinnedMessagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPinnedMessagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPinnedMessagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPinnedMessagesRequestValidationError) ErrorName() string {
+	return "ListPinnedMessagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPinnedMessagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPinnedMessagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPinnedMessagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPinnedMessagesRequestValidationError{}
+
+// Validate checks the field values on ListPinnedMessagesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPinnedMessagesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPinnedMessagesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPinnedMessagesResponseMultiError, or nil if none found.
+func (m *ListPinnedMessagesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPinnedMessagesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetChats() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPinnedMessagesResponseValidationError{
+						field:  fmt.Sprintf("Chats[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPinnedMessagesResponseValidationError{
+						field:  fmt.Sprintf("Chats[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPinnedMessagesResponseValidationError{
+					field:  fmt.Sprintf("Chats[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListPinnedMessagesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPinnedMessagesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListPinnedMessagesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListPinnedMessagesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPinnedMessagesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPinnedMessagesResponseMultiError) AllErrors() []error { return m }
+
+// ListPinnedMessagesResponseValidationError is the validation error returned
+// by ListPinnedMessagesResponse.Validate if the designated constraints aren't met.
+type ListPinnedMessagesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPinnedMessagesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPinnedMessagesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPinnedMessagesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPinnedMessagesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPinnedMessagesResponseValidationError) ErrorName() string {
+	return "ListPinnedMessagesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPinnedMessagesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPinnedMessagesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPinnedMessagesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPinnedMessagesResponseValidationError{}
+
+// Validate checks the field values on SearchMessagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchMessagesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchMessagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchMessagesRequestMultiError, or nil if none found.
+func (m *SearchMessagesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchMessagesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetQuery()); l < 1 || l > 200 {
+		err := SearchMessagesRequestValidationError{
+			field:  "Query",
+			reason: "value length must be between 1 and 200 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for GroupId
+
+	// no validation rules for AuthorId
+
+	// no validation rules for From
+
+	// no validation rules for To
+
+	if val := m.GetLimit(); val < 0 || val > 100 {
+		err := SearchMessagesRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOffset() < 0 {
+		err := SearchMessagesRequestValidationError{
+			field:  "Offset",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.HasAttachment != nil {
+		// no validation rules for HasAttachment
+	}
+
+	if len(errors) > 0 {
+		return SearchMessagesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchMessagesRequestMultiError is an error wrapping multiple validation
+// errors returned by SearchMessagesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SearchMessagesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchMessagesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchMessagesRequestMultiError) AllErrors() []error { return m }
+
+// SearchMessagesRequestValidationError is the validation error returned by
+// SearchMessagesRequest.Validate if the designated constraints aren't met.
+type SearchMessagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchMessagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchMessagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchMessagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchMessagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchMessagesRequestValidationError) ErrorName() string {
+	return "SearchMessagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchMessagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchMessagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchMessagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchMessagesRequestValidationError{}
+
+// Validate checks the field values on Highlight with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Highlight) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Highlight with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in HighlightMultiError, or nil
+// if none found.
+func (m *Highlight) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Highlight) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Start
+
+	// no validation rules for End
+
+	if len(errors) > 0 {
+		return HighlightMultiError(errors)
+	}
+
+	return nil
+}
+
+// HighlightMultiError is an error wrapping multiple validation errors returned
+// by Highlight.ValidateAll() if the designated constraints aren't met.
+type HighlightMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HighlightMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HighlightMultiError) AllErrors() []error { return m }
+
+// HighlightValidationError is the validation error returned by
+// Highlight.Validate if the designated constraints aren't met.
+type HighlightValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HighlightValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HighlightValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HighlightValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HighlightValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HighlightValidationError) ErrorName() string { return "HighlightValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HighlightValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHighlight.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HighlightValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HighlightValidationError{}
+
+// Validate checks the field values on SearchHit with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SearchHit) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchHit with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SearchHitMultiError, or nil
+// if none found.
+func (m *SearchHit) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchHit) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetChat()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchHitValidationError{
+					field:  "Chat",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchHitValidationError{
+					field:  "Chat",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetChat()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchHitValidationError{
+				field:  "Chat",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Snippet
+
+	for idx, item := range m.GetHighlights() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchHitValidationError{
+						field:  fmt.Sprintf("Highlights[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchHitValidationError{
+						field:  fmt.Sprintf("Highlights[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchHitValidationError{
+					field:  fmt.Sprintf("Highlights[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Score
+
+	if len(errors) > 0 {
+		return SearchHitMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchHitMultiError is an error wrapping multiple validation errors returned
+// by SearchHit.ValidateAll() if the designated constraints aren't met.
+type SearchHitMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchHitMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchHitMultiError) AllErrors() []error { return m }
+
+// SearchHitValidationError is the validation error returned by
+// SearchHit.Validate if the designated constraints aren't met.
+type SearchHitValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchHitValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchHitValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchHitValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchHitValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchHitValidationError) ErrorName() string { return "SearchHitValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SearchHitValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchHit.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchHitValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchHitValidationError{}
+
+// Validate checks the field values on SearchMessagesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchMessagesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchMessagesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchMessagesResponseMultiError, or nil if none found.
+func (m *SearchMessagesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchMessagesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetHits() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchMessagesResponseValidationError{
+						field:  fmt.Sprintf("Hits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchMessagesResponseValidationError{
+						field:  fmt.Sprintf("Hits[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchMessagesResponseValidationError{
+					field:  fmt.Sprintf("Hits[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return SearchMessagesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchMessagesResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchMessagesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchMessagesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchMessagesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchMessagesResponseMultiError) AllErrors() []error { return m }
+
+// SearchMessagesResponseValidationError is the validation error returned by
+// SearchMessagesResponse.Validate if the designated constraints aren't met.
+type SearchMessagesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchMessagesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchMessagesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchMessagesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchMessagesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchMessagesResponseValidationError) ErrorName() string {
+	return "SearchMessagesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchMessagesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchMessagesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchMessagesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchMessagesResponseValidationError{}
+
+// Validate checks the field values on ScheduleMessageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ScheduleMessageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ScheduleMessageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ScheduleMessageRequestMultiError, or nil if none found.
+func (m *ScheduleMessageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ScheduleMessageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGroupId() <= 0 {
+		err := ScheduleMessageRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetMessage()); l < 1 || l > 4000 {
+		err := ScheduleMessageRequestValidationError{
+			field:  "Message",
+			reason: "value length must be between 1 and 4000 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSendAt()) < 1 {
+		err := ScheduleMessageRequestValidationError{
+			field:  "SendAt",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ScheduleMessageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ScheduleMessageRequestMultiError is an error wrapping multiple validation
+// errors returned by ScheduleMessageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ScheduleMessageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ScheduleMessageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ScheduleMessageRequestMultiError) AllErrors() []error { return m }
+
+// ScheduleMessageRequestValidationError is the validation error returned by
+// ScheduleMessageRequest.Validate if the designated constraints aren't met.
+type ScheduleMessageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScheduleMessageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScheduleMessageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScheduleMessageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScheduleMessageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScheduleMessageRequestValidationError) ErrorName() string {
+	return "ScheduleMessageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ScheduleMessageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScheduleMessageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScheduleMessageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScheduleMessageRequestValidationError{}
+
+// Validate checks the field values on ScheduledMessage with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ScheduledMessage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ScheduledMessage with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ScheduledMessageMultiError, or nil if none found.
+func (m *ScheduledMessage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ScheduledMessage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for GroupId
+
+	// no validation rules for Message
+
+	// no validation rules for SendAt
+
+	// no validation rules for Status
+
+	// no validation rules for Error
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return ScheduledMessageMultiError(errors)
+	}
+
+	return nil
+}
+
+// ScheduledMessageMultiError is an error wrapping multiple validation errors
+// returned by ScheduledMessage.ValidateAll() if the designated constraints
+// aren't met.
+type ScheduledMessageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ScheduledMessageMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ScheduledMessageMultiError) AllErrors() []error { return m }
+
+// ScheduledMessageValidationError is the validation error returned by
+// ScheduledMessage.Validate if the designated constraints aren't met.
+type ScheduledMessageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScheduledMessageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScheduledMessageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScheduledMessageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScheduledMessageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScheduledMessageValidationError) ErrorName() string { return "ScheduledMessageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ScheduledMessageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScheduledMessage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScheduledMessageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScheduledMessageValidationError{}
+
+// Validate checks the field values on ScheduleMessageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ScheduleMessageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ScheduleMessageResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ScheduleMessageResponseMultiError, or nil if none found.
+func (m *ScheduleMessageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ScheduleMessageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetScheduledMessage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ScheduleMessageResponseValidationError{
+					field:  "ScheduledMessage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ScheduleMessageResponseValidationError{
+					field:  "ScheduledMessage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetScheduledMessage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ScheduleMessageResponseValidationError{
+				field:  "ScheduledMessage",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ScheduleMessageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ScheduleMessageResponseMultiError is an error wrapping multiple validation
+// errors returned by ScheduleMessageResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ScheduleMessageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ScheduleMessageResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ScheduleMessageResponseMultiError) AllErrors() []error { return m }
+
+// ScheduleMessageResponseValidationError is the validation error returned by
+// ScheduleMessageResponse.Validate if the designated constraints aren't met.
+type ScheduleMessageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScheduleMessageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScheduleMessageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScheduleMessageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScheduleMessageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScheduleMessageResponseValidationError) ErrorName() string {
+	return "ScheduleMessageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ScheduleMessageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScheduleMessageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScheduleMessageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScheduleMessageResponseValidationError{}
+
+// Validate checks the field values on ListScheduledMessagesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListScheduledMessagesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListScheduledMessagesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListScheduledMessagesRequestMultiError, or nil if none found.
+func (m *ListScheduledMessagesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListScheduledMessagesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for GroupId
+
+	if len(errors) > 0 {
+		return ListScheduledMessagesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListScheduledMessagesRequestMultiError is an error wrapping multiple
+// validation errors returned by ListScheduledMessagesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListScheduledMessagesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListScheduledMessagesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListScheduledMessagesRequestMultiError) AllErrors() []error { return m }
+
+// ListScheduledMessagesRequestValidationError is the validation error returned
+// by ListScheduledMessagesRequest.Validate if the designated constraints
+// aren't met.
+type ListScheduledMessagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListScheduledMessagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListScheduledMessagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListScheduledMessagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListScheduledMessagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListScheduledMessagesRequestValidationError) ErrorName() string {
+	return "ListScheduledMessagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListScheduledMessagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListScheduledMessagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListScheduledMessagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListScheduledMessagesRequestValidationError{}
+
+// Validate checks the field values on ListScheduledMessagesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListScheduledMessagesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListScheduledMessagesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListScheduledMessagesResponseMultiError, or nil if none found.
+func (m *ListScheduledMessagesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListScheduledMessagesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetScheduledMessages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListScheduledMessagesResponseValidationError{
+						field:  fmt.Sprintf("ScheduledMessages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListScheduledMessagesResponseValidationError{
+						field:  fmt.Sprintf("ScheduledMessages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListScheduledMessagesResponseValidationError{
+					field:  fmt.Sprintf("ScheduledMessages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListScheduledMessagesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListScheduledMessagesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListScheduledMessagesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListScheduledMessagesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListScheduledMessagesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListScheduledMessagesResponseMultiError) AllErrors() []error { return m }
+
+// ListScheduledMessagesResponseValidationError is the validation error
+// returned by ListScheduledMessagesResponse.Validate if the designated
+// constraints aren't met.
+type ListScheduledMessagesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListScheduledMessagesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListScheduledMessagesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListScheduledMessagesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListScheduledMessagesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListScheduledMessagesResponseValidationError) ErrorName() string {
+	return "ListScheduledMessagesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListScheduledMessagesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListScheduledMessagesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListScheduledMessagesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListScheduledMessagesResponseValidationError{}
+
+// Validate checks the field values on CancelScheduledMessageRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelScheduledMessageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelScheduledMessageRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CancelScheduledMessageRequestMultiError, or nil if none found.
+func (m *CancelScheduledMessageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelScheduledMessageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := CancelScheduledMessageRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CancelScheduledMessageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelScheduledMessageRequestMultiError is an error wrapping multiple
+// validation errors returned by CancelScheduledMessageRequest.ValidateAll()
+// if the designated constraints aren't met.
+type CancelScheduledMessageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelScheduledMessageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelScheduledMessageRequestMultiError) AllErrors() []error { return m }
+
+// CancelScheduledMessageRequestValidationError is the validation error
+// returned by CancelScheduledMessageRequest.Validate if the designated
+// constraints aren't met.
+type CancelScheduledMessageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelScheduledMessageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelScheduledMessageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelScheduledMessageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelScheduledMessageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelScheduledMessageRequestValidationError) ErrorName() string {
+	return "CancelScheduledMessageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelScheduledMessageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelScheduledMessageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelScheduledMessageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelScheduledMessageRequestValidationError{}
+
+// Validate checks the field values on CreateInviteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateInviteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateInviteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateInviteRequestMultiError, or nil if none found.
+func (m *CreateInviteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateInviteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGroupId() <= 0 {
+		err := CreateInviteRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreateInviteRequest_Role_InLookup[m.GetRole()]; !ok {
+		err := CreateInviteRequestValidationError{
+			field:  "Role",
+			reason: "value must be in list [ member admin]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxUses() < 0 {
+		err := CreateInviteRequestValidationError{
+			field:  "MaxUses",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetExpiresInSeconds(); val < 0 || val > 31536000 {
+		err := CreateInviteRequestValidationError{
+			field:  "ExpiresInSeconds",
+			reason: "value must be inside range [0, 31536000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateInviteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateInviteRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateInviteRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateInviteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateInviteRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateInviteRequestMultiError) AllErrors() []error { return m }
+
+// CreateInviteRequestValidationError is the validation error returned by
+// CreateInviteRequest.Validate if the designated constraints aren't met.
+type CreateInviteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateInviteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateInviteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateInviteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateInviteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateInviteRequestValidationError) ErrorName() string {
+	return "CreateInviteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateInviteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateInviteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateInviteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateInviteRequestValidationError{}
+
+var _CreateInviteRequest_Role_InLookup = map[string]struct{}{
+	"":       {},
+	"member": {},
+	"admin":  {},
+}
+
+// Validate checks the field values on Invite with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Invite) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Invite with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in InviteMultiError, or nil if none found.
+func (m *Invite) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Invite) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for GroupId
+
+	// no validation rules for Role
+
+	// no validation rules for MaxUses
+
+	// no validation rules for Uses
+
+	// no validation rules for ExpiresAt
+
+	// no validation rules for CreatedBy
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return InviteMultiError(errors)
+	}
+
+	return nil
+}
+
+// InviteMultiError is an error wrapping multiple validation errors returned by
+// Invite.ValidateAll() if the designated constraints aren't met.
+type InviteMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InviteMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InviteMultiError) AllErrors() []error { return m }
+
+// InviteValidationError is the validation error returned by Invite.Validate if
+// the designated constraints aren't met.
+type InviteValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InviteValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InviteValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InviteValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InviteValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InviteValidationError) ErrorName() string { return "InviteValidationError" }
+
+// Error satisfies the builtin error interface
+func (e InviteValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInvite.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InviteValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InviteValidationError{}
+
+// Validate checks the field values on CreateInviteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateInviteResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateInviteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateInviteResponseMultiError, or nil if none found.
+func (m *CreateInviteResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateInviteResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetInvite()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateInviteResponseValidationError{
+					field:  "Invite",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateInviteResponseValidationError{
+					field:  "Invite",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInvite()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateInviteResponseValidationError{
+				field:  "Invite",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateInviteResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateInviteResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateInviteResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateInviteResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateInviteResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateInviteResponseMultiError) AllErrors() []error { return m }
+
+// CreateInviteResponseValidationError is the validation error returned by
+// CreateInviteResponse.Validate if the designated constraints aren't met.
+type CreateInviteResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateInviteResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateInviteResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateInviteResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateInviteResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateInviteResponseValidationError) ErrorName() string {
+	return "CreateInviteResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateInviteResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateInviteResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateInviteResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateInviteResponseValidationError{}
+
+// Validate checks the field values on ListInvitesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListInvitesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListInvitesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListInvitesRequestMultiError, or nil if none found.
+func (m *ListInvitesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListInvitesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGroupId() <= 0 {
+		err := ListInvitesRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListInvitesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListInvitesRequestMultiError is an error wrapping multiple validation errors
+// returned by ListInvitesRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListInvitesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListInvitesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListInvitesRequestMultiError) AllErrors() []error { return m }
+
+// ListInvitesRequestValidationError is the validation error returned by
+// ListInvitesRequest.Validate if the designated constraints aren't met.
+type ListInvitesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListInvitesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListInvitesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListInvitesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListInvitesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListInvitesRequestValidationError) ErrorName() string {
+	return "ListInvitesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListInvitesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListInvitesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListInvitesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListInvitesRequestValidationError{}
+
+// Validate checks the field values on ListInvitesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListInvitesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListInvitesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListInvitesResponseMultiError, or nil if none found.
+func (m *ListInvitesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListInvitesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetInvites() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListInvitesResponseValidationError{
+						field:  fmt.Sprintf("Invites[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListInvitesResponseValidationError{
+						field:  fmt.Sprintf("Invites[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListInvitesResponseValidationError{
+					field:  fmt.Sprintf("Invites[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListInvitesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListInvitesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListInvitesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListInvitesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListInvitesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListInvitesResponseMultiError) AllErrors() []error { return m }
+
+// ListInvitesResponseValidationError is the validation error returned by
+// ListInvitesResponse.Validate if the designated constraints aren't met.
+type ListInvitesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListInvitesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListInvitesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListInvitesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListInvitesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListInvitesResponseValidationError) ErrorName() string {
+	return "ListInvitesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListInvitesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListInvitesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListInvitesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListInvitesResponseValidationError{}
+
+// Validate checks the field values on RevokeInviteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeInviteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeInviteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeInviteRequestMultiError, or nil if none found.
+func (m *RevokeInviteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeInviteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGroupId() <= 0 {
+		err := RevokeInviteRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCode()) < 1 {
+		err := RevokeInviteRequestValidationError{
+			field:  "Code",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RevokeInviteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeInviteRequestMultiError is an error wrapping multiple validation
+// errors returned by RevokeInviteRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeInviteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeInviteRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeInviteRequestMultiError) AllErrors() []error { return m }
+
+// RevokeInviteRequestValidationError is the validation error returned by
+// RevokeInviteRequest.Validate if the designated constraints aren't met.
+type RevokeInviteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeInviteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeInviteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeInviteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeInviteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeInviteRequestValidationError) ErrorName() string {
+	return "RevokeInviteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeInviteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeInviteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeInviteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeInviteRequestValidationError{}
+
+// Validate checks the field values on JoinByInviteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *JoinByInviteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JoinByInviteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JoinByInviteRequestMultiError, or nil if none found.
+func (m *JoinByInviteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JoinByInviteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetCode()); l < 1 || l > 32 {
+		err := JoinByInviteRequestValidationError{
+			field:  "Code",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return JoinByInviteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// JoinByInviteRequestMultiError is an error wrapping multiple validation
+// errors returned by JoinByInviteRequest.ValidateAll() if the designated
+// constraints aren't met.
+type JoinByInviteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JoinByInviteRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JoinByInviteRequestMultiError) AllErrors() []error { return m }
+
+// JoinByInviteRequestValidationError is the validation error returned by
+// JoinByInviteRequest.Validate if the designated constraints aren't met.
+type JoinByInviteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JoinByInviteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JoinByInviteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JoinByInviteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JoinByInviteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JoinByInviteRequestValidationError) ErrorName() string {
+	return "JoinByInviteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e JoinByInviteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJoinByInviteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JoinByInviteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JoinByInviteRequestValidationError{}
+
+// Validate checks the field values on JoinByInviteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *JoinByInviteResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JoinByInviteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JoinByInviteResponseMultiError, or nil if none found.
+func (m *JoinByInviteResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JoinByInviteResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for GroupId
+
+	// no validation rules for MemberId
+
+	// no validation rules for Role
+
+	if len(errors) > 0 {
+		return JoinByInviteResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// JoinByInviteResponseMultiError is an error wrapping multiple validation
+// errors returned by JoinByInviteResponse.ValidateAll() if the designated
+// constraints aren't met.
+type JoinByInviteResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JoinByInviteResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JoinByInviteResponseMultiError) AllErrors() []error { return m }
+
+// JoinByInviteResponseValidationError is the validation error returned by
+// JoinByInviteResponse.Validate if the designated constraints aren't met.
+type JoinByInviteResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JoinByInviteResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JoinByInviteResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JoinByInviteResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JoinByInviteResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JoinByInviteResponseValidationError) ErrorName() string {
+	return "JoinByInviteResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e JoinByInviteResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJoinByInviteResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JoinByInviteResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JoinByInviteResponseValidationError{}
+
+// Validate checks the field values on JoinRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *JoinRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JoinRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in JoinRequestMultiError, or
+// nil if none found.
+func (m *JoinRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JoinRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for GroupId
+
+	// no validation rules for UserId
+
+	// no validation rules for Username
+
+	// no validation rules for Note
+
+	// no validation rules for Status
+
+	// no validation rules for ReviewedBy
+
+	// no validation rules for ReviewedAt
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return JoinRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// JoinRequestMultiError is an error wrapping multiple validation errors
+// returned by JoinRequest.ValidateAll() if the designated constraints aren't met.
+type JoinRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JoinRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JoinRequestMultiError) AllErrors() []error { return m }
+
+// JoinRequestValidationError is the validation error returned by
+// JoinRequest.Validate if the designated constraints aren't met.
+type JoinRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JoinRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JoinRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JoinRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JoinRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JoinRequestValidationError) ErrorName() string { return "JoinRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JoinRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJoinRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JoinRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JoinRequestValidationError{}
+
+// Validate checks the field values on RequestToJoinRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestToJoinRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestToJoinRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RequestToJoinRequestMultiError, or nil if none found.
+func (m *RequestToJoinRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestToJoinRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGroupId() <= 0 {
+		err := RequestToJoinRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNote()) > 500 {
+		err := RequestToJoinRequestValidationError{
+			field:  "Note",
+			reason: "value length must be at most 500 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RequestToJoinRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequestToJoinRequestMultiError is an error wrapping multiple validation
+// errors returned by RequestToJoinRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RequestToJoinRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestToJoinRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestToJoinRequestMultiError) AllErrors() []error { return m }
+
+// RequestToJoinRequestValidationError is the validation error returned by
+// RequestToJoinRequest.Validate if the designated constraints aren't met.
+type RequestToJoinRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestToJoinRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestToJoinRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestToJoinRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestToJoinRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestToJoinRequestValidationError) ErrorName() string {
+	return "RequestToJoinRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestToJoinRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestToJoinRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestToJoinRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestToJoinRequestValidationError{}
+
+// Validate checks the field values on RequestToJoinResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestToJoinResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestToJoinResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RequestToJoinResponseMultiError, or nil if none found.
+func (m *RequestToJoinResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestToJoinResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetJoinRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RequestToJoinResponseValidationError{
+					field:  "JoinRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RequestToJoinResponseValidationError{
+					field:  "JoinRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJoinRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RequestToJoinResponseValidationError{
+				field:  "JoinRequest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RequestToJoinResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequestToJoinResponseMultiError is an error wrapping multiple validation
+// errors returned by RequestToJoinResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RequestToJoinResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestToJoinResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestToJoinResponseMultiError) AllErrors() []error { return m }
+
+// RequestToJoinResponseValidationError is the validation error returned by
+// RequestToJoinResponse.Validate if the designated constraints aren't met.
+type RequestToJoinResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1121,24 +9364,24 @@ type UpdateRoleUserRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateRoleUserRequestValidationError) Field() string { return e.field }
+func (e RequestToJoinResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateRoleUserRequestValidationError) Reason() string { return e.reason }
+func (e RequestToJoinResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateRoleUserRequestValidationError) Cause() error { return e.cause }
+func (e RequestToJoinResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateRoleUserRequestValidationError) Key() bool { return e.key }
+func (e RequestToJoinResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateRoleUserRequestValidationError) ErrorName() string {
-	return "UpdateRoleUserRequestValidationError"
+func (e RequestToJoinResponseValidationError) ErrorName() string {
+	return "RequestToJoinResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateRoleUserRequestValidationError) Error() string {
+func (e RequestToJoinResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1150,14 +9393,14 @@ func (e UpdateRoleUserRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateRoleUserRequest.%s: %s%s",
+		"invalid %sRequestToJoinResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateRoleUserRequestValidationError{}
+var _ error = RequestToJoinResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1165,24 +9408,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateRoleUserRequestValidationError{}
+} = RequestToJoinResponseValidationError{}
 
-// Validate checks the field values on AddMemberRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AddMemberRequest) Validate() error {
+// Validate checks the field values on ListJoinRequestsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListJoinRequestsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddMemberRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListJoinRequestsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AddMemberRequestMultiError, or nil if none found.
-func (m *AddMemberRequest) ValidateAll() error {
+// ListJoinRequestsRequestMultiError, or nil if none found.
+func (m *ListJoinRequestsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddMemberRequest) validate(all bool) error {
+func (m *ListJoinRequestsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1190,7 +9433,7 @@ func (m *AddMemberRequest) validate(all bool) error {
 	var errors []error
 
 	if m.GetGroupId() <= 0 {
-		err := AddMemberRequestValidationError{
+		err := ListJoinRequestsRequestValidationError{
 			field:  "GroupId",
 			reason: "value must be greater than 0",
 		}
@@ -1200,54 +9443,20 @@ func (m *AddMemberRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	for idx, item := range m.GetListUserId() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AddMemberRequestValidationError{
-						field:  fmt.Sprintf("ListUserId[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AddMemberRequestValidationError{
-						field:  fmt.Sprintf("ListUserId[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return AddMemberRequestValidationError{
-					field:  fmt.Sprintf("ListUserId[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return AddMemberRequestMultiError(errors)
+		return ListJoinRequestsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddMemberRequestMultiError is an error wrapping multiple validation errors
-// returned by AddMemberRequest.ValidateAll() if the designated constraints
-// aren't met.
-type AddMemberRequestMultiError []error
+// ListJoinRequestsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListJoinRequestsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListJoinRequestsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddMemberRequestMultiError) Error() string {
+func (m ListJoinRequestsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1256,11 +9465,11 @@ func (m AddMemberRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddMemberRequestMultiError) AllErrors() []error { return m }
+func (m ListJoinRequestsRequestMultiError) AllErrors() []error { return m }
 
-// AddMemberRequestValidationError is the validation error returned by
-// AddMemberRequest.Validate if the designated constraints aren't met.
-type AddMemberRequestValidationError struct {
+// ListJoinRequestsRequestValidationError is the validation error returned by
+// ListJoinRequestsRequest.Validate if the designated constraints aren't met.
+type ListJoinRequestsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1268,22 +9477,24 @@ type AddMemberRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddMemberRequestValidationError) Field() string { return e.field }
+func (e ListJoinRequestsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddMemberRequestValidationError) Reason() string { return e.reason }
+func (e ListJoinRequestsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddMemberRequestValidationError) Cause() error { return e.cause }
+func (e ListJoinRequestsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddMemberRequestValidationError) Key() bool { return e.key }
+func (e ListJoinRequestsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddMemberRequestValidationError) ErrorName() string { return "AddMemberRequestValidationError" }
+func (e ListJoinRequestsRequestValidationError) ErrorName() string {
+	return "ListJoinRequestsRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AddMemberRequestValidationError) Error() string {
+func (e ListJoinRequestsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1295,14 +9506,14 @@ func (e AddMemberRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddMemberRequest.%s: %s%s",
+		"invalid %sListJoinRequestsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AddMemberRequestValidationError{}
+var _ error = ListJoinRequestsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1310,58 +9521,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddMemberRequestValidationError{}
+} = ListJoinRequestsRequestValidationError{}
 
-// Validate checks the field values on RemoveMemberRequest with the rules
+// Validate checks the field values on ListJoinRequestsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RemoveMemberRequest) Validate() error {
+func (m *ListJoinRequestsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RemoveMemberRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListJoinRequestsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RemoveMemberRequestMultiError, or nil if none found.
-func (m *RemoveMemberRequest) ValidateAll() error {
+// ListJoinRequestsResponseMultiError, or nil if none found.
+func (m *ListJoinRequestsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveMemberRequest) validate(all bool) error {
+func (m *ListJoinRequestsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetGroupId() <= 0 {
-		err := RemoveMemberRequestValidationError{
-			field:  "GroupId",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	for idx, item := range m.GetListMemberId() {
+	for idx, item := range m.GetJoinRequests() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, RemoveMemberRequestValidationError{
-						field:  fmt.Sprintf("ListMemberId[%v]", idx),
+					errors = append(errors, ListJoinRequestsResponseValidationError{
+						field:  fmt.Sprintf("JoinRequests[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, RemoveMemberRequestValidationError{
-						field:  fmt.Sprintf("ListMemberId[%v]", idx),
+					errors = append(errors, ListJoinRequestsResponseValidationError{
+						field:  fmt.Sprintf("JoinRequests[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -1369,8 +9569,8 @@ func (m *RemoveMemberRequest) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return RemoveMemberRequestValidationError{
-					field:  fmt.Sprintf("ListMemberId[%v]", idx),
+				return ListJoinRequestsResponseValidationError{
+					field:  fmt.Sprintf("JoinRequests[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -1380,19 +9580,19 @@ func (m *RemoveMemberRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return RemoveMemberRequestMultiError(errors)
+		return ListJoinRequestsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RemoveMemberRequestMultiError is an error wrapping multiple validation
-// errors returned by RemoveMemberRequest.ValidateAll() if the designated
+// ListJoinRequestsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListJoinRequestsResponse.ValidateAll() if the designated
 // constraints aren't met.
-type RemoveMemberRequestMultiError []error
+type ListJoinRequestsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveMemberRequestMultiError) Error() string {
+func (m ListJoinRequestsResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1401,11 +9601,11 @@ func (m RemoveMemberRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveMemberRequestMultiError) AllErrors() []error { return m }
+func (m ListJoinRequestsResponseMultiError) AllErrors() []error { return m }
 
-// RemoveMemberRequestValidationError is the validation error returned by
-// RemoveMemberRequest.Validate if the designated constraints aren't met.
-type RemoveMemberRequestValidationError struct {
+// ListJoinRequestsResponseValidationError is the validation error returned by
+// ListJoinRequestsResponse.Validate if the designated constraints aren't met.
+type ListJoinRequestsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1413,24 +9613,24 @@ type RemoveMemberRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveMemberRequestValidationError) Field() string { return e.field }
+func (e ListJoinRequestsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveMemberRequestValidationError) Reason() string { return e.reason }
+func (e ListJoinRequestsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveMemberRequestValidationError) Cause() error { return e.cause }
+func (e ListJoinRequestsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveMemberRequestValidationError) Key() bool { return e.key }
+func (e ListJoinRequestsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveMemberRequestValidationError) ErrorName() string {
-	return "RemoveMemberRequestValidationError"
+func (e ListJoinRequestsResponseValidationError) ErrorName() string {
+	return "ListJoinRequestsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveMemberRequestValidationError) Error() string {
+func (e ListJoinRequestsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1442,14 +9642,14 @@ func (e RemoveMemberRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveMemberRequest.%s: %s%s",
+		"invalid %sListJoinRequestsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveMemberRequestValidationError{}
+var _ error = ListJoinRequestsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1457,33 +9657,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveMemberRequestValidationError{}
+} = ListJoinRequestsResponseValidationError{}
 
-// Validate checks the field values on ListUserId with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *ListUserId) Validate() error {
+// Validate checks the field values on ApproveJoinRequestRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApproveJoinRequestRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListUserId with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ListUserIdMultiError, or
-// nil if none found.
-func (m *ListUserId) ValidateAll() error {
+// ValidateAll checks the field values on ApproveJoinRequestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApproveJoinRequestRequestMultiError, or nil if none found.
+func (m *ApproveJoinRequestRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListUserId) validate(all bool) error {
+func (m *ApproveJoinRequestRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetUserId() <= 0 {
-		err := ListUserIdValidationError{
-			field:  "UserId",
+	if m.GetGroupId() <= 0 {
+		err := ApproveJoinRequestRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetRequestId() <= 0 {
+		err := ApproveJoinRequestRequestValidationError{
+			field:  "RequestId",
 			reason: "value must be greater than 0",
 		}
 		if !all {
@@ -1493,18 +9704,19 @@ func (m *ListUserId) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ListUserIdMultiError(errors)
+		return ApproveJoinRequestRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListUserIdMultiError is an error wrapping multiple validation errors
-// returned by ListUserId.ValidateAll() if the designated constraints aren't met.
-type ListUserIdMultiError []error
+// ApproveJoinRequestRequestMultiError is an error wrapping multiple validation
+// errors returned by ApproveJoinRequestRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ApproveJoinRequestRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListUserIdMultiError) Error() string {
+func (m ApproveJoinRequestRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1513,11 +9725,11 @@ func (m ListUserIdMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListUserIdMultiError) AllErrors() []error { return m }
+func (m ApproveJoinRequestRequestMultiError) AllErrors() []error { return m }
 
-// ListUserIdValidationError is the validation error returned by
-// ListUserId.Validate if the designated constraints aren't met.
-type ListUserIdValidationError struct {
+// ApproveJoinRequestRequestValidationError is the validation error returned by
+// ApproveJoinRequestRequest.Validate if the designated constraints aren't met.
+type ApproveJoinRequestRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1525,22 +9737,24 @@ type ListUserIdValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListUserIdValidationError) Field() string { return e.field }
+func (e ApproveJoinRequestRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListUserIdValidationError) Reason() string { return e.reason }
+func (e ApproveJoinRequestRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListUserIdValidationError) Cause() error { return e.cause }
+func (e ApproveJoinRequestRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListUserIdValidationError) Key() bool { return e.key }
+func (e ApproveJoinRequestRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListUserIdValidationError) ErrorName() string { return "ListUserIdValidationError" }
+func (e ApproveJoinRequestRequestValidationError) ErrorName() string {
+	return "ApproveJoinRequestRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ListUserIdValidationError) Error() string {
+func (e ApproveJoinRequestRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1552,14 +9766,14 @@ func (e ListUserIdValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListUserId.%s: %s%s",
+		"invalid %sApproveJoinRequestRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListUserIdValidationError{}
+var _ error = ApproveJoinRequestRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1567,24 +9781,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListUserIdValidationError{}
+} = ApproveJoinRequestRequestValidationError{}
 
-// Validate checks the field values on ExitGroupRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ExitGroupRequest) Validate() error {
+// Validate checks the field values on RejectJoinRequestRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RejectJoinRequestRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ExitGroupRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on RejectJoinRequestRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ExitGroupRequestMultiError, or nil if none found.
-func (m *ExitGroupRequest) ValidateAll() error {
+// RejectJoinRequestRequestMultiError, or nil if none found.
+func (m *RejectJoinRequestRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ExitGroupRequest) validate(all bool) error {
+func (m *RejectJoinRequestRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1592,7 +9806,7 @@ func (m *ExitGroupRequest) validate(all bool) error {
 	var errors []error
 
 	if m.GetGroupId() <= 0 {
-		err := ExitGroupRequestValidationError{
+		err := RejectJoinRequestRequestValidationError{
 			field:  "GroupId",
 			reason: "value must be greater than 0",
 		}
@@ -1602,20 +9816,31 @@ func (m *ExitGroupRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if m.GetRequestId() <= 0 {
+		err := RejectJoinRequestRequestValidationError{
+			field:  "RequestId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return ExitGroupRequestMultiError(errors)
+		return RejectJoinRequestRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ExitGroupRequestMultiError is an error wrapping multiple validation errors
-// returned by ExitGroupRequest.ValidateAll() if the designated constraints
-// aren't met.
-type ExitGroupRequestMultiError []error
+// RejectJoinRequestRequestMultiError is an error wrapping multiple validation
+// errors returned by RejectJoinRequestRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RejectJoinRequestRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ExitGroupRequestMultiError) Error() string {
+func (m RejectJoinRequestRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1624,11 +9849,11 @@ func (m ExitGroupRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ExitGroupRequestMultiError) AllErrors() []error { return m }
+func (m RejectJoinRequestRequestMultiError) AllErrors() []error { return m }
 
-// ExitGroupRequestValidationError is the validation error returned by
-// ExitGroupRequest.Validate if the designated constraints aren't met.
-type ExitGroupRequestValidationError struct {
+// RejectJoinRequestRequestValidationError is the validation error returned by
+// RejectJoinRequestRequest.Validate if the designated constraints aren't met.
+type RejectJoinRequestRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1636,22 +9861,24 @@ type ExitGroupRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ExitGroupRequestValidationError) Field() string { return e.field }
+func (e RejectJoinRequestRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ExitGroupRequestValidationError) Reason() string { return e.reason }
+func (e RejectJoinRequestRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ExitGroupRequestValidationError) Cause() error { return e.cause }
+func (e RejectJoinRequestRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ExitGroupRequestValidationError) Key() bool { return e.key }
+func (e RejectJoinRequestRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ExitGroupRequestValidationError) ErrorName() string { return "ExitGroupRequestValidationError" }
+func (e RejectJoinRequestRequestValidationError) ErrorName() string {
+	return "RejectJoinRequestRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ExitGroupRequestValidationError) Error() string {
+func (e RejectJoinRequestRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1663,14 +9890,14 @@ func (e ExitGroupRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sExitGroupRequest.%s: %s%s",
+		"invalid %sRejectJoinRequestRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ExitGroupRequestValidationError{}
+var _ error = RejectJoinRequestRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1678,46 +9905,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ExitGroupRequestValidationError{}
+} = RejectJoinRequestRequestValidationError{}
 
-// Validate checks the field values on StatusResponse with the rules defined in
+// Validate checks the field values on GroupPreview with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *StatusResponse) Validate() error {
+func (m *GroupPreview) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on StatusResponse with the rules defined
+// ValidateAll checks the field values on GroupPreview with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in StatusResponseMultiError,
-// or nil if none found.
-func (m *StatusResponse) ValidateAll() error {
+// result is a list of violation errors wrapped in GroupPreviewMultiError, or
+// nil if none found.
+func (m *GroupPreview) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *StatusResponse) validate(all bool) error {
+func (m *GroupPreview) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Status
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for Visibility
+
+	// no validation rules for MemberCount
+
+	// no validation rules for IsMember
 
 	if len(errors) > 0 {
-		return StatusResponseMultiError(errors)
+		return GroupPreviewMultiError(errors)
 	}
 
 	return nil
 }
 
-// StatusResponseMultiError is an error wrapping multiple validation errors
-// returned by StatusResponse.ValidateAll() if the designated constraints
-// aren't met.
-type StatusResponseMultiError []error
+// GroupPreviewMultiError is an error wrapping multiple validation errors
+// returned by GroupPreview.ValidateAll() if the designated constraints aren't met.
+type GroupPreviewMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m StatusResponseMultiError) Error() string {
+func (m GroupPreviewMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1726,11 +9962,11 @@ func (m StatusResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m StatusResponseMultiError) AllErrors() []error { return m }
+func (m GroupPreviewMultiError) AllErrors() []error { return m }
 
-// StatusResponseValidationError is the validation error returned by
-// StatusResponse.Validate if the designated constraints aren't met.
-type StatusResponseValidationError struct {
+// GroupPreviewValidationError is the validation error returned by
+// GroupPreview.Validate if the designated constraints aren't met.
+type GroupPreviewValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1738,22 +9974,22 @@ type StatusResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e StatusResponseValidationError) Field() string { return e.field }
+func (e GroupPreviewValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e StatusResponseValidationError) Reason() string { return e.reason }
+func (e GroupPreviewValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e StatusResponseValidationError) Cause() error { return e.cause }
+func (e GroupPreviewValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e StatusResponseValidationError) Key() bool { return e.key }
+func (e GroupPreviewValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e StatusResponseValidationError) ErrorName() string { return "StatusResponseValidationError" }
+func (e GroupPreviewValidationError) ErrorName() string { return "GroupPreviewValidationError" }
 
 // Error satisfies the builtin error interface
-func (e StatusResponseValidationError) Error() string {
+func (e GroupPreviewValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1765,14 +10001,14 @@ func (e StatusResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sStatusResponse.%s: %s%s",
+		"invalid %sGroupPreview.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = StatusResponseValidationError{}
+var _ error = GroupPreviewValidationError{}
 
 var _ interface {
 	Field() string
@@ -1780,34 +10016,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = StatusResponseValidationError{}
+} = GroupPreviewValidationError{}
 
-// Validate checks the field values on ChatStreamingRequest with the rules
+// Validate checks the field values on SearchPublicGroupsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ChatStreamingRequest) Validate() error {
+func (m *SearchPublicGroupsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ChatStreamingRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ChatStreamingRequestMultiError, or nil if none found.
-func (m *ChatStreamingRequest) ValidateAll() error {
+// ValidateAll checks the field values on SearchPublicGroupsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchPublicGroupsRequestMultiError, or nil if none found.
+func (m *SearchPublicGroupsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ChatStreamingRequest) validate(all bool) error {
+func (m *SearchPublicGroupsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetGroupId() <= 0 {
-		err := ChatStreamingRequestValidationError{
-			field:  "GroupId",
-			reason: "value must be greater than 0",
+	if utf8.RuneCountInString(m.GetQuery()) > 100 {
+		err := SearchPublicGroupsRequestValidationError{
+			field:  "Query",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 50 {
+		err := SearchPublicGroupsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 50]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOffset() < 0 {
+		err := SearchPublicGroupsRequestValidationError{
+			field:  "Offset",
+			reason: "value must be greater than or equal to 0",
 		}
 		if !all {
 			return err
@@ -1816,19 +10074,19 @@ func (m *ChatStreamingRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ChatStreamingRequestMultiError(errors)
+		return SearchPublicGroupsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ChatStreamingRequestMultiError is an error wrapping multiple validation
-// errors returned by ChatStreamingRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ChatStreamingRequestMultiError []error
+// SearchPublicGroupsRequestMultiError is an error wrapping multiple validation
+// errors returned by SearchPublicGroupsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type SearchPublicGroupsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ChatStreamingRequestMultiError) Error() string {
+func (m SearchPublicGroupsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1837,11 +10095,11 @@ func (m ChatStreamingRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ChatStreamingRequestMultiError) AllErrors() []error { return m }
+func (m SearchPublicGroupsRequestMultiError) AllErrors() []error { return m }
 
-// ChatStreamingRequestValidationError is the validation error returned by
-// ChatStreamingRequest.Validate if the designated constraints aren't met.
-type ChatStreamingRequestValidationError struct {
+// SearchPublicGroupsRequestValidationError is the validation error returned by
+// SearchPublicGroupsRequest.Validate if the designated constraints aren't met.
+type SearchPublicGroupsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1849,24 +10107,24 @@ type ChatStreamingRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ChatStreamingRequestValidationError) Field() string { return e.field }
+func (e SearchPublicGroupsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ChatStreamingRequestValidationError) Reason() string { return e.reason }
+func (e SearchPublicGroupsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ChatStreamingRequestValidationError) Cause() error { return e.cause }
+func (e SearchPublicGroupsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ChatStreamingRequestValidationError) Key() bool { return e.key }
+func (e SearchPublicGroupsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ChatStreamingRequestValidationError) ErrorName() string {
-	return "ChatStreamingRequestValidationError"
+func (e SearchPublicGroupsRequestValidationError) ErrorName() string {
+	return "SearchPublicGroupsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ChatStreamingRequestValidationError) Error() string {
+func (e SearchPublicGroupsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1878,14 +10136,14 @@ func (e ChatStreamingRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sChatStreamingRequest.%s: %s%s",
+		"invalid %sSearchPublicGroupsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ChatStreamingRequestValidationError{}
+var _ error = SearchPublicGroupsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1893,57 +10151,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ChatStreamingRequestValidationError{}
+} = SearchPublicGroupsRequestValidationError{}
 
-// Validate checks the field values on ChatStreamingResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on SearchPublicGroupsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ChatStreamingResponse) Validate() error {
+func (m *SearchPublicGroupsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ChatStreamingResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SearchPublicGroupsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ChatStreamingResponseMultiError, or nil if none found.
-func (m *ChatStreamingResponse) ValidateAll() error {
+// SearchPublicGroupsResponseMultiError, or nil if none found.
+func (m *SearchPublicGroupsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ChatStreamingResponse) validate(all bool) error {
+func (m *SearchPublicGroupsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Member
-
-	// no validation rules for Username
-
-	// no validation rules for Message
-
-	// no validation rules for Timestamp
-
-	// no validation rules for Action
-
-	for idx, item := range m.GetReadStatus() {
+	for idx, item := range m.GetGroups() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChatStreamingResponseValidationError{
-						field:  fmt.Sprintf("ReadStatus[%v]", idx),
+					errors = append(errors, SearchPublicGroupsResponseValidationError{
+						field:  fmt.Sprintf("Groups[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ChatStreamingResponseValidationError{
-						field:  fmt.Sprintf("ReadStatus[%v]", idx),
+					errors = append(errors, SearchPublicGroupsResponseValidationError{
+						field:  fmt.Sprintf("Groups[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -1951,8 +10199,8 @@ func (m *ChatStreamingResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ChatStreamingResponseValidationError{
-					field:  fmt.Sprintf("ReadStatus[%v]", idx),
+				return SearchPublicGroupsResponseValidationError{
+					field:  fmt.Sprintf("Groups[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -1961,20 +10209,22 @@ func (m *ChatStreamingResponse) validate(all bool) error {
 
 	}
 
+	// no validation rules for Total
+
 	if len(errors) > 0 {
-		return ChatStreamingResponseMultiError(errors)
+		return SearchPublicGroupsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ChatStreamingResponseMultiError is an error wrapping multiple validation
-// errors returned by ChatStreamingResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ChatStreamingResponseMultiError []error
+// SearchPublicGroupsResponseMultiError is an error wrapping multiple
+// validation errors returned by SearchPublicGroupsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type SearchPublicGroupsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ChatStreamingResponseMultiError) Error() string {
+func (m SearchPublicGroupsResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1983,11 +10233,11 @@ func (m ChatStreamingResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ChatStreamingResponseMultiError) AllErrors() []error { return m }
+func (m SearchPublicGroupsResponseMultiError) AllErrors() []error { return m }
 
-// ChatStreamingResponseValidationError is the validation error returned by
-// ChatStreamingResponse.Validate if the designated constraints aren't met.
-type ChatStreamingResponseValidationError struct {
+// SearchPublicGroupsResponseValidationError is the validation error returned
+// by SearchPublicGroupsResponse.Validate if the designated constraints aren't met.
+type SearchPublicGroupsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1995,24 +10245,24 @@ type ChatStreamingResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ChatStreamingResponseValidationError) Field() string { return e.field }
+func (e SearchPublicGroupsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ChatStreamingResponseValidationError) Reason() string { return e.reason }
+func (e SearchPublicGroupsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ChatStreamingResponseValidationError) Cause() error { return e.cause }
+func (e SearchPublicGroupsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ChatStreamingResponseValidationError) Key() bool { return e.key }
+func (e SearchPublicGroupsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ChatStreamingResponseValidationError) ErrorName() string {
-	return "ChatStreamingResponseValidationError"
+func (e SearchPublicGroupsResponseValidationError) ErrorName() string {
+	return "SearchPublicGroupsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ChatStreamingResponseValidationError) Error() string {
+func (e SearchPublicGroupsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2024,14 +10274,14 @@ func (e ChatStreamingResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sChatStreamingResponse.%s: %s%s",
+		"invalid %sSearchPublicGroupsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ChatStreamingResponseValidationError{}
+var _ error = SearchPublicGroupsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2039,24 +10289,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ChatStreamingResponseValidationError{}
+} = SearchPublicGroupsResponseValidationError{}
 
-// Validate checks the field values on StatusStreamingRequest with the rules
+// Validate checks the field values on GetGroupPreviewRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *StatusStreamingRequest) Validate() error {
+func (m *GetGroupPreviewRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on StatusStreamingRequest with the rules
+// ValidateAll checks the field values on GetGroupPreviewRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// StatusStreamingRequestMultiError, or nil if none found.
-func (m *StatusStreamingRequest) ValidateAll() error {
+// GetGroupPreviewRequestMultiError, or nil if none found.
+func (m *GetGroupPreviewRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *StatusStreamingRequest) validate(all bool) error {
+func (m *GetGroupPreviewRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2064,7 +10314,7 @@ func (m *StatusStreamingRequest) validate(all bool) error {
 	var errors []error
 
 	if m.GetGroupId() <= 0 {
-		err := StatusStreamingRequestValidationError{
+		err := GetGroupPreviewRequestValidationError{
 			field:  "GroupId",
 			reason: "value must be greater than 0",
 		}
@@ -2075,19 +10325,19 @@ func (m *StatusStreamingRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return StatusStreamingRequestMultiError(errors)
+		return GetGroupPreviewRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// StatusStreamingRequestMultiError is an error wrapping multiple validation
-// errors returned by StatusStreamingRequest.ValidateAll() if the designated
+// GetGroupPreviewRequestMultiError is an error wrapping multiple validation
+// errors returned by GetGroupPreviewRequest.ValidateAll() if the designated
 // constraints aren't met.
-type StatusStreamingRequestMultiError []error
+type GetGroupPreviewRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m StatusStreamingRequestMultiError) Error() string {
+func (m GetGroupPreviewRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2096,11 +10346,11 @@ func (m StatusStreamingRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m StatusStreamingRequestMultiError) AllErrors() []error { return m }
+func (m GetGroupPreviewRequestMultiError) AllErrors() []error { return m }
 
-// StatusStreamingRequestValidationError is the validation error returned by
-// StatusStreamingRequest.Validate if the designated constraints aren't met.
-type StatusStreamingRequestValidationError struct {
+// GetGroupPreviewRequestValidationError is the validation error returned by
+// GetGroupPreviewRequest.Validate if the designated constraints aren't met.
+type GetGroupPreviewRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2108,24 +10358,24 @@ type StatusStreamingRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e StatusStreamingRequestValidationError) Field() string { return e.field }
+func (e GetGroupPreviewRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e StatusStreamingRequestValidationError) Reason() string { return e.reason }
+func (e GetGroupPreviewRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e StatusStreamingRequestValidationError) Cause() error { return e.cause }
+func (e GetGroupPreviewRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e StatusStreamingRequestValidationError) Key() bool { return e.key }
+func (e GetGroupPreviewRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e StatusStreamingRequestValidationError) ErrorName() string {
-	return "StatusStreamingRequestValidationError"
+func (e GetGroupPreviewRequestValidationError) ErrorName() string {
+	return "GetGroupPreviewRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e StatusStreamingRequestValidationError) Error() string {
+func (e GetGroupPreviewRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2137,14 +10387,14 @@ func (e StatusStreamingRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sStatusStreamingRequest.%s: %s%s",
+		"invalid %sGetGroupPreviewRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = StatusStreamingRequestValidationError{}
+var _ error = GetGroupPreviewRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2152,50 +10402,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = StatusStreamingRequestValidationError{}
+} = GetGroupPreviewRequestValidationError{}
 
-// Validate checks the field values on StatusStreamingResponse with the rules
+// Validate checks the field values on GetGroupPreviewResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *StatusStreamingResponse) Validate() error {
+func (m *GetGroupPreviewResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on StatusStreamingResponse with the
+// ValidateAll checks the field values on GetGroupPreviewResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// StatusStreamingResponseMultiError, or nil if none found.
-func (m *StatusStreamingResponse) ValidateAll() error {
+// GetGroupPreviewResponseMultiError, or nil if none found.
+func (m *GetGroupPreviewResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *StatusStreamingResponse) validate(all bool) error {
+func (m *GetGroupPreviewResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Member
-
-	// no validation rules for Username
-
-	// no validation rules for Status
+	if all {
+		switch v := interface{}(m.GetGroup()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetGroupPreviewResponseValidationError{
+					field:  "Group",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetGroupPreviewResponseValidationError{
+					field:  "Group",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGroup()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetGroupPreviewResponseValidationError{
+				field:  "Group",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return StatusStreamingResponseMultiError(errors)
+		return GetGroupPreviewResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// StatusStreamingResponseMultiError is an error wrapping multiple validation
-// errors returned by StatusStreamingResponse.ValidateAll() if the designated
+// GetGroupPreviewResponseMultiError is an error wrapping multiple validation
+// errors returned by GetGroupPreviewResponse.ValidateAll() if the designated
 // constraints aren't met.
-type StatusStreamingResponseMultiError []error
+type GetGroupPreviewResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m StatusStreamingResponseMultiError) Error() string {
+func (m GetGroupPreviewResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2204,11 +10477,11 @@ func (m StatusStreamingResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m StatusStreamingResponseMultiError) AllErrors() []error { return m }
+func (m GetGroupPreviewResponseMultiError) AllErrors() []error { return m }
 
-// StatusStreamingResponseValidationError is the validation error returned by
-// StatusStreamingResponse.Validate if the designated constraints aren't met.
-type StatusStreamingResponseValidationError struct {
+// GetGroupPreviewResponseValidationError is the validation error returned by
+// GetGroupPreviewResponse.Validate if the designated constraints aren't met.
+type GetGroupPreviewResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2216,24 +10489,24 @@ type StatusStreamingResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e StatusStreamingResponseValidationError) Field() string { return e.field }
+func (e GetGroupPreviewResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e StatusStreamingResponseValidationError) Reason() string { return e.reason }
+func (e GetGroupPreviewResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e StatusStreamingResponseValidationError) Cause() error { return e.cause }
+func (e GetGroupPreviewResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e StatusStreamingResponseValidationError) Key() bool { return e.key }
+func (e GetGroupPreviewResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e StatusStreamingResponseValidationError) ErrorName() string {
-	return "StatusStreamingResponseValidationError"
+func (e GetGroupPreviewResponseValidationError) ErrorName() string {
+	return "GetGroupPreviewResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e StatusStreamingResponseValidationError) Error() string {
+func (e GetGroupPreviewResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2245,14 +10518,14 @@ func (e StatusStreamingResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sStatusStreamingResponse.%s: %s%s",
+		"invalid %sGetGroupPreviewResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = StatusStreamingResponseValidationError{}
+var _ error = GetGroupPreviewResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2260,78 +10533,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = StatusStreamingResponseValidationError{}
+} = GetGroupPreviewResponseValidationError{}
 
-// Validate checks the field values on GetListGroupResponse with the rules
+// Validate checks the field values on JoinPublicGroupRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetListGroupResponse) Validate() error {
+func (m *JoinPublicGroupRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetListGroupResponse with the rules
+// ValidateAll checks the field values on JoinPublicGroupRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetListGroupResponseMultiError, or nil if none found.
-func (m *GetListGroupResponse) ValidateAll() error {
+// JoinPublicGroupRequestMultiError, or nil if none found.
+func (m *JoinPublicGroupRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetListGroupResponse) validate(all bool) error {
+func (m *JoinPublicGroupRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetGroup() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetListGroupResponseValidationError{
-						field:  fmt.Sprintf("Group[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetListGroupResponseValidationError{
-						field:  fmt.Sprintf("Group[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetListGroupResponseValidationError{
-					field:  fmt.Sprintf("Group[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+	if m.GetGroupId() <= 0 {
+		err := JoinPublicGroupRequestValidationError{
+			field:  "GroupId",
+			reason: "value must be greater than 0",
 		}
-
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return GetListGroupResponseMultiError(errors)
+		return JoinPublicGroupRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetListGroupResponseMultiError is an error wrapping multiple validation
-// errors returned by GetListGroupResponse.ValidateAll() if the designated
+// JoinPublicGroupRequestMultiError is an error wrapping multiple validation
+// errors returned by JoinPublicGroupRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GetListGroupResponseMultiError []error
+type JoinPublicGroupRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetListGroupResponseMultiError) Error() string {
+func (m JoinPublicGroupRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2340,11 +10590,11 @@ func (m GetListGroupResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetListGroupResponseMultiError) AllErrors() []error { return m }
+func (m JoinPublicGroupRequestMultiError) AllErrors() []error { return m }
 
-// GetListGroupResponseValidationError is the validation error returned by
-// GetListGroupResponse.Validate if the designated constraints aren't met.
-type GetListGroupResponseValidationError struct {
+// JoinPublicGroupRequestValidationError is the validation error returned by
+// JoinPublicGroupRequest.Validate if the designated constraints aren't met.
+type JoinPublicGroupRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2352,24 +10602,24 @@ type GetListGroupResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetListGroupResponseValidationError) Field() string { return e.field }
+func (e JoinPublicGroupRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetListGroupResponseValidationError) Reason() string { return e.reason }
+func (e JoinPublicGroupRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetListGroupResponseValidationError) Cause() error { return e.cause }
+func (e JoinPublicGroupRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetListGroupResponseValidationError) Key() bool { return e.key }
+func (e JoinPublicGroupRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetListGroupResponseValidationError) ErrorName() string {
-	return "GetListGroupResponseValidationError"
+func (e JoinPublicGroupRequestValidationError) ErrorName() string {
+	return "JoinPublicGroupRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetListGroupResponseValidationError) Error() string {
+func (e JoinPublicGroupRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2381,14 +10631,14 @@ func (e GetListGroupResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetListGroupResponse.%s: %s%s",
+		"invalid %sJoinPublicGroupRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetListGroupResponseValidationError{}
+var _ error = JoinPublicGroupRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2396,49 +10646,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetListGroupResponseValidationError{}
+} = JoinPublicGroupRequestValidationError{}
 
-// Validate checks the field values on GroupInfo with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GroupInfo) Validate() error {
+// Validate checks the field values on JoinPublicGroupResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *JoinPublicGroupResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GroupInfo with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GroupInfoMultiError, or nil
-// if none found.
-func (m *GroupInfo) ValidateAll() error {
+// ValidateAll checks the field values on JoinPublicGroupResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JoinPublicGroupResponseMultiError, or nil if none found.
+func (m *JoinPublicGroupResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GroupInfo) validate(all bool) error {
+func (m *JoinPublicGroupResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for GroupId
 
-	// no validation rules for Name
+	// no validation rules for MemberId
 
-	// no validation rules for LastMessage
+	// no validation rules for Role
 
 	if len(errors) > 0 {
-		return GroupInfoMultiError(errors)
+		return JoinPublicGroupResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GroupInfoMultiError is an error wrapping multiple validation errors returned
-// by GroupInfo.ValidateAll() if the designated constraints aren't met.
-type GroupInfoMultiError []error
+// JoinPublicGroupResponseMultiError is an error wrapping multiple validation
+// errors returned by JoinPublicGroupResponse.ValidateAll() if the designated
+// constraints aren't met.
+type JoinPublicGroupResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GroupInfoMultiError) Error() string {
+func (m JoinPublicGroupResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2447,11 +10698,11 @@ func (m GroupInfoMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GroupInfoMultiError) AllErrors() []error { return m }
+func (m JoinPublicGroupResponseMultiError) AllErrors() []error { return m }
 
-// GroupInfoValidationError is the validation error returned by
-// GroupInfo.Validate if the designated constraints aren't met.
-type GroupInfoValidationError struct {
+// JoinPublicGroupResponseValidationError is the validation error returned by
+// JoinPublicGroupResponse.Validate if the designated constraints aren't met.
+type JoinPublicGroupResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2459,22 +10710,24 @@ type GroupInfoValidationError struct {
 }
 
 // Field function returns field value.
-func (e GroupInfoValidationError) Field() string { return e.field }
+func (e JoinPublicGroupResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GroupInfoValidationError) Reason() string { return e.reason }
+func (e JoinPublicGroupResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GroupInfoValidationError) Cause() error { return e.cause }
+func (e JoinPublicGroupResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GroupInfoValidationError) Key() bool { return e.key }
+func (e JoinPublicGroupResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GroupInfoValidationError) ErrorName() string { return "GroupInfoValidationError" }
+func (e JoinPublicGroupResponseValidationError) ErrorName() string {
+	return "JoinPublicGroupResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GroupInfoValidationError) Error() string {
+func (e JoinPublicGroupResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2486,14 +10739,14 @@ func (e GroupInfoValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGroupInfo.%s: %s%s",
+		"invalid %sJoinPublicGroupResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GroupInfoValidationError{}
+var _ error = JoinPublicGroupResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2501,4 +10754,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GroupInfoValidationError{}
+} = JoinPublicGroupResponseValidationError{}
@@ -0,0 +1,304 @@
+package gateway
+
+import (
+	"chat_api/pb"
+	"chat_api/utils/interceptor"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const pingInterval = 15 * time.Second
+
+// bridge exposes ChatStreaming and StatusStreaming to browsers over WebSocket
+// and Server-Sent Events. The gRPC handlers are driven in-process through
+// bridgeStream, so browser subscribers are registered in the same usecase
+// stream registry as native clients and receive identical events.
+type bridge struct {
+	chatServer pb.ChatServiceServer
+}
+
+var upgrader = websocket.Upgrader{
+	// token dikirim lewat header atau query, bukan cookie, jadi origin tidak dibatasi
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+func (b *bridge) chatStreaming(w http.ResponseWriter, r *http.Request) {
+	groupId, err := strconv.ParseUint(r.PathValue("group_id"), 10, 64)
+	if err != nil {
+		writeError(w, status.Error(codes.InvalidArgument, "invalid group_id"))
+		return
+	}
+
+	b.serve(w, r, "chat", func(ctx context.Context, s sink) error {
+		req := &pb.ChatStreamingRequest{GroupId: groupId}
+		if err := interceptor.ValidateMessage(req); err != nil {
+			return err
+		}
+		return b.chatServer.ChatStreaming(req, &bridgeStream[pb.ChatStreamingResponse]{ctx: ctx, sink: s})
+	})
+}
+
+func (b *bridge) statusStreaming(w http.ResponseWriter, r *http.Request) {
+	groupId, err := strconv.ParseUint(r.PathValue("group_id"), 10, 64)
+	if err != nil {
+		writeError(w, status.Error(codes.InvalidArgument, "invalid group_id"))
+		return
+	}
+
+	b.serve(w, r, "status", func(ctx context.Context, s sink) error {
+		req := &pb.StatusStreamingRequest{GroupId: groupId}
+		if err := interceptor.ValidateMessage(req); err != nil {
+			return err
+		}
+		return b.chatServer.StatusStreaming(req, &bridgeStream[pb.StatusStreamingResponse]{ctx: ctx, sink: s})
+	})
+}
+
+// serve authenticates the request and runs the stream over WebSocket when the
+// client asks for an upgrade, or over SSE otherwise. EventSource and the
+// browser WebSocket API cannot set headers, so the token may also be passed
+// as the access_token query parameter.
+func (b *bridge) serve(w http.ResponseWriter, r *http.Request, event string, handler func(ctx context.Context, s sink) error) {
+	// handler dipanggil langsung tanpa interceptor gRPC, jadi validasi dan pemetaan error dilakukan di sini
+	run := func(ctx context.Context, s sink) error {
+		if err := handler(ctx, s); err != nil {
+			return interceptor.ToStatus(r.URL.Path, err).Err()
+		}
+		return nil
+	}
+
+	authHeader := r.Header.Get("Authorization")
+	if token := r.URL.Query().Get("access_token"); authHeader == "" && token != "" {
+		authHeader = "Bearer " + token
+	}
+
+	ctx, err := interceptor.Authenticate(r.Context(), authHeader)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if websocket.IsWebSocketUpgrade(r) {
+		serveWebSocket(ctx, w, r, run)
+		return
+	}
+	serveSSE(ctx, w, event, run)
+}
+
+func serveWebSocket(ctx context.Context, w http.ResponseWriter, r *http.Request, run func(ctx context.Context, s sink) error) {
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// client tidak mengirim apa-apa, read loop hanya untuk mendeteksi koneksi ditutup
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	s := &wsSink{conn: conn}
+	go keepAlive(ctx, s)
+
+	closeMsg := websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")
+	if err := run(ctx, s); err != nil {
+		closeMsg = websocket.FormatCloseMessage(websocket.CloseInternalServerErr, status.Convert(err).Message())
+		if status.Code(err) == codes.Unauthenticated || status.Code(err) == codes.PermissionDenied {
+			closeMsg = websocket.FormatCloseMessage(websocket.ClosePolicyViolation, status.Convert(err).Message())
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	conn.WriteControl(websocket.CloseMessage, closeMsg, time.Now().Add(time.Second))
+}
+
+func serveSSE(ctx context.Context, w http.ResponseWriter, event string, run func(ctx context.Context, s sink) error) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, status.Error(codes.Internal, "streaming unsupported"))
+		return
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	s := &sseSink{w: w, flusher: flusher, event: event}
+	go keepAlive(ctx, s)
+
+	err := run(ctx, s)
+	cancel()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err == nil {
+		return
+	}
+	// error sebelum event pertama masih bisa dikirim sebagai status HTTP
+	if !s.started {
+		writeError(w, err)
+		return
+	}
+	s.writeEvent("error", []byte(strconv.Quote(status.Convert(err).Message())))
+}
+
+func keepAlive(ctx context.Context, s sink) {
+	ticker := time.NewTicker(pingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.ping(); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// writeError replies with the HTTP status mapped from the gRPC code and the
+// status body, in the same shape as the REST gateway errors.
+func writeError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	body, _ := jsonMarshal.Marshal(st.Proto())
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(runtime.HTTPStatusFromCode(st.Code()))
+	w.Write(body)
+}
+
+type sink interface {
+	send(msg proto.Message) error
+	ping() error
+}
+
+type wsSink struct {
+	mu   sync.Mutex
+	conn *websocket.Conn
+}
+
+func (s *wsSink) send(msg proto.Message) error {
+	data, err := jsonMarshal.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.conn.WriteMessage(websocket.TextMessage, data)
+}
+
+func (s *wsSink) ping() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(time.Second))
+}
+
+type sseSink struct {
+	mu      sync.Mutex
+	w       http.ResponseWriter
+	flusher http.Flusher
+	event   string
+	started bool
+}
+
+func (s *sseSink) send(msg proto.Message) error {
+	data, err := jsonMarshal.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.writeEvent(s.event, data)
+}
+
+func (s *sseSink) ping() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.start()
+	if _, err := io.WriteString(s.w, ": ping\n\n"); err != nil {
+		return err
+	}
+	s.flusher.Flush()
+	return nil
+}
+
+// caller must hold s.mu
+func (s *sseSink) writeEvent(event string, data []byte) error {
+	s.start()
+	if _, err := fmt.Fprintf(s.w, "event: %s\ndata: %s\n\n", event, data); err != nil {
+		return err
+	}
+	s.flusher.Flush()
+	return nil
+}
+
+// caller must hold s.mu
+func (s *sseSink) start() {
+	if s.started {
+		return
+	}
+	s.started = true
+	s.w.Header().Set("Content-Type", "text/event-stream")
+	s.w.Header().Set("Cache-Control", "no-cache")
+	s.w.Header().Set("Connection", "keep-alive")
+	s.w.WriteHeader(http.StatusOK)
+}
+
+// bridgeStream adapts a sink to grpc.ServerStreamingServer so the bridge can
+// call the ChatServiceServer stream handlers directly.
+type bridgeStream[Res any] struct {
+	ctx  context.Context
+	sink sink
+}
+
+func (s *bridgeStream[Res]) Send(msg *Res) error {
+	return s.SendMsg(msg)
+}
+
+func (s *bridgeStream[Res]) SendMsg(m any) error {
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return status.Errorf(codes.Internal, "unexpected message type %T", m)
+	}
+	return s.sink.send(msg)
+}
+
+func (s *bridgeStream[Res]) RecvMsg(m any) error {
+	return io.EOF
+}
+
+func (s *bridgeStream[Res]) Context() context.Context {
+	return s.ctx
+}
+
+func (s *bridgeStream[Res]) SetHeader(metadata.MD) error {
+	return nil
+}
+
+func (s *bridgeStream[Res]) SendHeader(metadata.MD) error {
+	return nil
+}
+
+func (s *bridgeStream[Res]) SetTrailer(metadata.MD) {}
@@ -0,0 +1,64 @@
+package gateway
+
+import (
+	"chat_api/docs"
+	"chat_api/pb"
+	"context"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var jsonMarshal = protojson.MarshalOptions{
+	UseProtoNames:   true,
+	EmitUnpopulated: true,
+}
+
+// NewHandler builds the HTTP/JSON gateway. Requests are transcoded and sent
+// to the gRPC server at grpcAddr, so they pass through the same interceptors
+// as native clients: the Authorization header is forwarded as the
+// "authorization" metadata key, and gRPC status codes are written back as
+// HTTP statuses by runtime.DefaultHTTPErrorHandler. The chat and status
+// streams are served from chatServer over WebSocket and SSE.
+func NewHandler(ctx context.Context, grpcAddr string, chatServer pb.ChatServiceServer) (http.Handler, error) {
+	gwMux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+			MarshalOptions: jsonMarshal,
+			UnmarshalOptions: protojson.UnmarshalOptions{
+				DiscardUnknown: true,
+			},
+		}),
+	)
+
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	if err := pb.RegisterAuthHandlerFromEndpoint(ctx, gwMux, grpcAddr, opts); err != nil {
+		return nil, err
+	}
+	if err := pb.RegisterChatServiceHandlerFromEndpoint(ctx, gwMux, grpcAddr, opts); err != nil {
+		return nil, err
+	}
+	if err := pb.RegisterUserServiceHandlerFromEndpoint(ctx, gwMux, grpcAddr, opts); err != nil {
+		return nil, err
+	}
+
+	b := &bridge{chatServer: chatServer}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/openapi.json", serveOpenAPI)
+	mux.HandleFunc("GET /v1/groups/{group_id}/chats/stream", b.chatStreaming)
+	mux.HandleFunc("GET /v1/groups/{group_id}/status/stream", b.statusStreaming)
+	mux.Handle("/", gwMux)
+	return mux, nil
+}
+
+func serveOpenAPI(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(docs.OpenAPI)
+}
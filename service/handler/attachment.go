@@ -0,0 +1,145 @@
+package handler
+
+import (
+	"chat_api/pb"
+	"chat_api/utils/apperror"
+	"chat_api/utils/helper"
+	"chat_api/utils/interceptor"
+	"errors"
+	"io"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const downloadChunkSize = 64 << 10
+
+// attachment upload: first message is AttachmentInfo, the rest are chunks
+func (s *ChatServer) UploadAttachment(stream pb.ChatService_UploadAttachmentServer) error {
+	ctx := stream.Context()
+
+	claims, ok := ctx.Value(interceptor.UserContextKey).(*helper.JWTClaims)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "invalid jwt")
+	}
+
+	first, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return apperror.Invalid("attachment info is required")
+	}
+	if err != nil {
+		return err
+	}
+	info := first.GetInfo()
+	if info == nil {
+		return apperror.Invalid("first message must be attachment info", apperror.FieldViolation{
+			Field:       "info",
+			Description: "value is required in the first message",
+		})
+	}
+
+	ismember, memberId, err := s.chatUsecase.GetGroupMemberID(ctx, claims.UserID, uint(info.GroupId))
+	if err != nil {
+		return err
+	}
+	if !ismember {
+		return apperror.PermissionDenied("you arent member")
+	}
+
+	attachment, err := s.chatUsecase.UploadAttachment(ctx, helper.ParsingPbToAttachmentInfo(info, memberId), &chunkReader{stream: stream})
+	if err != nil {
+		return err
+	}
+
+	return stream.SendAndClose(&pb.UploadAttachmentResponse{
+		Attachment: helper.ConvertAttachmentToPb(attachment),
+	})
+}
+
+// attachment download: info first, then the content in chunks
+func (s *ChatServer) DownloadAttachment(req *pb.DownloadAttachmentRequest, stream pb.ChatService_DownloadAttachmentServer) error {
+	ctx := stream.Context()
+
+	claims, ok := ctx.Value(interceptor.UserContextKey).(*helper.JWTClaims)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "invalid jwt")
+	}
+
+	attachment, err := s.chatUsecase.GetAttachment(ctx, uint(req.AttachmentId))
+	if err != nil {
+		return err
+	}
+
+	// avatar bisa diunduh semua user
+	isAvatar, err := s.chatUsecase.IsAvatarAttachment(ctx, attachment)
+	if err != nil {
+		return err
+	}
+	if !isAvatar {
+		ismember, memberId, err := s.chatUsecase.GetGroupMemberID(ctx, claims.UserID, attachment.GroupID)
+		if err != nil {
+			return err
+		}
+		if !ismember {
+			return apperror.PermissionDenied("you arent member")
+		}
+		// attachment yang belum dikirim ke chat hanya untuk pengunggahnya
+		if attachment.ChatID == nil && (attachment.GroupMemberID == nil || *attachment.GroupMemberID != memberId) {
+			return apperror.NotFound("attachment not found")
+		}
+	}
+
+	content, err := s.chatUsecase.OpenAttachment(ctx, attachment, int(req.ThumbnailSize))
+	if err != nil {
+		return err
+	}
+	defer content.Close()
+
+	if err := stream.Send(&pb.DownloadAttachmentResponse{
+		Data: &pb.DownloadAttachmentResponse_Info{Info: helper.ConvertAttachmentToPb(attachment)},
+	}); err != nil {
+		return err
+	}
+
+	buf := make([]byte, downloadChunkSize)
+	for {
+		n, err := content.Read(buf)
+		if n > 0 {
+			if err := stream.Send(&pb.DownloadAttachmentResponse{
+				Data: &pb.DownloadAttachmentResponse_Chunk{Chunk: buf[:n]},
+			}); err != nil {
+				return err
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return apperror.Wrap(apperror.KindInternal, "internal server error", err)
+		}
+	}
+}
+
+// chunkReader reads the chunks of an upload stream as one io.Reader
+type chunkReader struct {
+	stream pb.ChatService_UploadAttachmentServer
+	buf    []byte
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		req, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		chunk, ok := req.Data.(*pb.UploadAttachmentRequest_Chunk)
+		if !ok {
+			return 0, apperror.Invalid("attachment info must only be sent once")
+		}
+		r.buf = chunk.Chunk
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
@@ -5,10 +5,6 @@ import (
 	"chat_api/service/usecase"
 	"chat_api/utils/helper"
 	"context"
-	"fmt"
-
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 type AuthServer struct {
@@ -21,9 +17,6 @@ func NewAuthHandler(authUsecase usecase.AuthUsecase) *AuthServer {
 }
 
 func (s *AuthServer) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error) {
-	if err := req.Validate(); err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "Validation error: %v", err)
-	}
 	user := helper.ParsingPbToLogin(req)
 
 	token, err := s.authUsecase.Login(ctx, user)
@@ -38,18 +31,10 @@ func (s *AuthServer) Login(ctx context.Context, req *pb.LoginRequest) (*pb.Login
 }
 
 func (s *AuthServer) Register(ctx context.Context, req *pb.RegisterRequest) (*pb.RegisterResponse, error) {
-	if err := req.Validate(); err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "Validation error: %v", err)
-	}
-
-	fmt.Println(req)
 	user := helper.ParsingPbToRegister(req)
 
-	fmt.Println(user)
 	if err := s.authUsecase.Register(ctx, user); err != nil {
-		return &pb.RegisterResponse{
-			Message: "failed to register",
-		}, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
 	return &pb.RegisterResponse{
@@ -1,13 +1,13 @@
 package handler
 
 import (
+	"chat_api/entity"
 	"chat_api/pb"
 	"chat_api/service/usecase"
+	"chat_api/utils/apperror"
 	"chat_api/utils/helper"
 	"chat_api/utils/interceptor"
 	"context"
-	"fmt"
-	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -25,10 +25,6 @@ func NewChatHandler(chatUsecase usecase.ChatUsecase) *ChatServer {
 
 // write chat
 func (s *ChatServer) CreateChat(ctx context.Context, req *pb.CreateChatRequest) (*pb.CreateChatResponse, error) {
-	if err := req.Validate(); err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "Validation error: %v", err)
-	}
-
 	claimsRaw := ctx.Value(interceptor.UserContextKey)
 	claims, ok := claimsRaw.(*helper.JWTClaims)
 	if !ok {
@@ -36,96 +32,96 @@ func (s *ChatServer) CreateChat(ctx context.Context, req *pb.CreateChatRequest)
 	}
 	ismember, memberId, err := s.chatUsecase.GetGroupMemberID(ctx, claims.UserID, uint(req.GroupId))
 	if err != nil {
-		return &pb.CreateChatResponse{Message: "failed to send message"}, status.Error(codes.Internal, "server error")
+		return nil, err
 	}
 	if !ismember {
-		return &pb.CreateChatResponse{Message: "unautorized"}, status.Error(codes.Unauthenticated, "you arent member")
+		return nil, apperror.PermissionDenied("you arent member")
 	}
 
 	chat := helper.ParsingPbToCreateChat(req, memberId)
 
 	cb, err := s.chatUsecase.CreateChat(ctx, chat)
 	if err != nil {
-		return &pb.CreateChatResponse{Message: "failed to send message"}, status.Error(codes.Internal, "server error")
+		return nil, err
 	}
 
 	s.chatUsecase.ChatBroadcast(cb, 0)
+	s.chatUsecase.ThreadBroadcast(ctx, cb)
 
 	return &pb.CreateChatResponse{Message: "success send message"}, nil
 }
 
 func (s *ChatServer) DeleteChat(ctx context.Context, req *pb.DeleteChatRequest) (*pb.StatusResponse, error) {
-	if err := req.Validate(); err != nil {
-		return &pb.StatusResponse{
-			Status: false,
-		}, status.Error(codes.Canceled, "invalid message")
-	}
-
 	claimsRaw := ctx.Value(interceptor.UserContextKey)
 	claims, ok := claimsRaw.(*helper.JWTClaims)
 	if !ok {
-		return &pb.StatusResponse{
-			Status: false,
-		}, status.Error(codes.Unauthenticated, "invalid jwt")
+		return nil, status.Error(codes.Unauthenticated, "invalid jwt")
 	}
 
-	ismember, _, err := s.chatUsecase.GetGroupMemberID(ctx, claims.UserID, uint(req.GroupId))
+	ismember, memberId, err := s.chatUsecase.GetGroupMemberID(ctx, claims.UserID, uint(req.GroupId))
 	if err != nil {
-		return &pb.StatusResponse{
-			Status: false,
-		}, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 	if !ismember {
-		return &pb.StatusResponse{
-			Status: false,
-		}, status.Error(codes.Unauthenticated, "you arent member")
+		return nil, apperror.PermissionDenied("you arent member")
 	}
 
-	chat, err := s.chatUsecase.DeleteChat(ctx, uint(req.ChatId))
+	chat, err := s.chatUsecase.DeleteChat(ctx, uint(req.GroupId), uint(req.ChatId), memberId)
 	if err != nil {
-		return &pb.StatusResponse{
-			Status: false,
-		}, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
 	s.chatUsecase.ChatBroadcast(chat, 2)
+	s.chatUsecase.ThreadBroadcast(ctx, chat)
 	return &pb.StatusResponse{
 		Status: true,
 	}, nil
 }
 
-func (s *ChatServer) UpdateChat(ctx context.Context, req *pb.UpdateChatRequest) (*pb.StatusResponse, error) {
-	if err := req.Validate(); err != nil {
-		return &pb.StatusResponse{
-			Status: false,
-		}, status.Error(codes.Canceled, "invalid message")
+func (s *ChatServer) PurgeChat(ctx context.Context, req *pb.PurgeChatRequest) (*pb.StatusResponse, error) {
+	claims, ok := ctx.Value(interceptor.UserContextKey).(*helper.JWTClaims)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "invalid jwt")
+	}
+
+	ismember, memberId, err := s.chatUsecase.GetGroupMemberID(ctx, claims.UserID, uint(req.GroupId))
+	if err != nil {
+		return nil, err
+	}
+	if !ismember {
+		return nil, apperror.PermissionDenied("you arent member")
 	}
 
+	chat, err := s.chatUsecase.PurgeChat(ctx, uint(req.GroupId), uint(req.ChatId), memberId)
+	if err != nil {
+		return nil, err
+	}
+
+	s.chatUsecase.ChatBroadcast(chat, int(pb.Action_Purge))
+	s.chatUsecase.ThreadBroadcast(ctx, chat)
+	return &pb.StatusResponse{
+		Status: true,
+	}, nil
+}
+
+func (s *ChatServer) UpdateChat(ctx context.Context, req *pb.UpdateChatRequest) (*pb.StatusResponse, error) {
 	claimsRaw := ctx.Value(interceptor.UserContextKey)
 	claims, ok := claimsRaw.(*helper.JWTClaims)
 	if !ok {
-		return &pb.StatusResponse{
-			Status: false,
-		}, status.Error(codes.Unauthenticated, "invalid jwt")
+		return nil, status.Error(codes.Unauthenticated, "invalid jwt")
 	}
 
-	ismember, _, err := s.chatUsecase.GetGroupMemberID(ctx, claims.UserID, uint(req.GroupId))
+	ismember, memberId, err := s.chatUsecase.GetGroupMemberID(ctx, claims.UserID, uint(req.GroupId))
 	if err != nil {
-		return &pb.StatusResponse{
-			Status: false,
-		}, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 	if !ismember {
-		return &pb.StatusResponse{
-			Status: false,
-		}, status.Error(codes.Unauthenticated, "you arent member")
+		return nil, apperror.PermissionDenied("you arent member")
 	}
 
-	chat, err := s.chatUsecase.UpdateChat(ctx, uint(req.ChatId), req.Message)
+	chat, err := s.chatUsecase.UpdateChat(ctx, uint(req.GroupId), uint(req.ChatId), memberId, req.Message)
 	if err != nil {
-		return &pb.StatusResponse{
-			Status: false,
-		}, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
 	s.chatUsecase.ChatBroadcast(chat, 1)
@@ -137,24 +133,14 @@ func (s *ChatServer) UpdateChat(ctx context.Context, req *pb.UpdateChatRequest)
 
 // write group & member
 func (s *ChatServer) CreateGroup(ctx context.Context, req *pb.CreateGroupRequest) (*pb.StatusResponse, error) {
-	if err := req.Validate(); err != nil {
-		return &pb.StatusResponse{
-			Status: false,
-		}, status.Error(codes.Canceled, "invalid message")
-	}
-
 	claimsRaw := ctx.Value(interceptor.UserContextKey)
 	claims, ok := claimsRaw.(*helper.JWTClaims)
 	if !ok {
-		return &pb.StatusResponse{
-			Status: false,
-		}, status.Error(codes.Unauthenticated, "invalid jwt")
+		return nil, status.Error(codes.Unauthenticated, "invalid jwt")
 	}
 
-	if err := s.chatUsecase.CreateGroup(ctx, req.Name, req.Desc, claims.UserID); err != nil {
-		return &pb.StatusResponse{
-			Status: false,
-		}, status.Error(codes.Internal, err.Error())
+	if err := s.chatUsecase.CreateGroup(ctx, req.Name, req.Desc, req.Visibility, claims.UserID); err != nil {
+		return nil, err
 	}
 
 	return &pb.StatusResponse{
@@ -163,36 +149,22 @@ func (s *ChatServer) CreateGroup(ctx context.Context, req *pb.CreateGroupRequest
 }
 
 func (s *ChatServer) DeleteGroup(ctx context.Context, req *pb.DeleteGroupRequest) (*pb.StatusResponse, error) {
-	if err := req.Validate(); err != nil {
-		return &pb.StatusResponse{
-			Status: false,
-		}, status.Error(codes.Canceled, "invalid message")
-	}
-
 	claimsRaw := ctx.Value(interceptor.UserContextKey)
 	claims, ok := claimsRaw.(*helper.JWTClaims)
 	if !ok {
-		return &pb.StatusResponse{
-			Status: false,
-		}, status.Error(codes.Unauthenticated, "invalid jwt")
+		return nil, status.Error(codes.Unauthenticated, "invalid jwt")
 	}
 
 	ismember, memberId, err := s.chatUsecase.GetGroupMemberID(ctx, claims.UserID, uint(req.GroupId))
 	if err != nil {
-		return &pb.StatusResponse{
-			Status: false,
-		}, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 	if !ismember {
-		return &pb.StatusResponse{
-			Status: false,
-		}, status.Error(codes.Unauthenticated, "you arent member")
+		return nil, apperror.PermissionDenied("you arent member")
 	}
 
 	if err := s.chatUsecase.DeleteGroup(ctx, memberId, uint(req.GroupId)); err != nil {
-		return &pb.StatusResponse{
-			Status: false,
-		}, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
 	return &pb.StatusResponse{
@@ -201,36 +173,22 @@ func (s *ChatServer) DeleteGroup(ctx context.Context, req *pb.DeleteGroupRequest
 }
 
 func (s *ChatServer) UpdateGroup(ctx context.Context, req *pb.UpdateGroupRequest) (*pb.StatusResponse, error) {
-	if err := req.Validate(); err != nil {
-		return &pb.StatusResponse{
-			Status: false,
-		}, status.Error(codes.Canceled, "invalid message")
-	}
-
 	claimsRaw := ctx.Value(interceptor.UserContextKey)
 	claims, ok := claimsRaw.(*helper.JWTClaims)
 	if !ok {
-		return &pb.StatusResponse{
-			Status: false,
-		}, status.Error(codes.Internal, "invalid jwt")
+		return nil, status.Error(codes.Unauthenticated, "invalid jwt")
 	}
 
 	ismember, memberId, err := s.chatUsecase.GetGroupMemberID(ctx, claims.UserID, uint(req.GroupId))
 	if err != nil {
-		return &pb.StatusResponse{
-			Status: false,
-		}, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 	if !ismember {
-		return &pb.StatusResponse{
-			Status: false,
-		}, status.Error(codes.Unauthenticated, "you arent member")
+		return nil, apperror.PermissionDenied("you arent member")
 	}
 
-	if err := s.chatUsecase.UpdateGroup(ctx, req.Name, req.Desc, memberId, uint(req.GroupId)); err != nil {
-		return &pb.StatusResponse{
-			Status: false,
-		}, status.Error(codes.Internal, err.Error())
+	if err := s.chatUsecase.UpdateGroup(ctx, req.Name, req.Desc, memberId, uint(req.GroupId), req.MessageTtlSeconds, req.Visibility); err != nil {
+		return nil, err
 	}
 
 	return &pb.StatusResponse{
@@ -239,38 +197,23 @@ func (s *ChatServer) UpdateGroup(ctx context.Context, req *pb.UpdateGroupRequest
 }
 
 func (s *ChatServer) AddMember(ctx context.Context, req *pb.AddMemberRequest) (*pb.StatusResponse, error) {
-	if err := req.Validate(); err != nil {
-		return &pb.StatusResponse{
-			Status: false,
-		}, status.Error(codes.Canceled, "invalid message")
-	}
-
 	claimsRaw := ctx.Value(interceptor.UserContextKey)
 	claims, ok := claimsRaw.(*helper.JWTClaims)
 	if !ok {
-		return &pb.StatusResponse{
-			Status: false,
-		}, status.Error(codes.Internal, "invalid jwt")
+		return nil, status.Error(codes.Unauthenticated, "invalid jwt")
 	}
 
 	ismember, memberId, err := s.chatUsecase.GetGroupMemberID(ctx, claims.UserID, uint(req.GroupId))
 	if err != nil {
-		return &pb.StatusResponse{
-			Status: false,
-		}, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 	if !ismember {
-		return &pb.StatusResponse{
-			Status: false,
-		}, status.Error(codes.Unauthenticated, "you arent member")
+		return nil, apperror.PermissionDenied("you arent member")
 	}
 
-	fmt.Println(req.ListUserId)
 	newMembers := helper.ParsingPBtoAddMember(req.ListUserId, uint(req.GroupId))
-	if err := s.chatUsecase.AddMember(newMembers, memberId); err != nil {
-		return &pb.StatusResponse{
-			Status: false,
-		}, status.Error(codes.Internal, err.Error())
+	if err := s.chatUsecase.AddMember(ctx, newMembers, memberId); err != nil {
+		return nil, err
 	}
 
 	return &pb.StatusResponse{
@@ -279,37 +222,23 @@ func (s *ChatServer) AddMember(ctx context.Context, req *pb.AddMemberRequest) (*
 }
 
 func (s *ChatServer) RemoveMember(ctx context.Context, req *pb.RemoveMemberRequest) (*pb.StatusResponse, error) {
-	if err := req.Validate(); err != nil {
-		return &pb.StatusResponse{
-			Status: false,
-		}, status.Error(codes.Canceled, "invalid message")
-	}
-
 	claimsRaw := ctx.Value(interceptor.UserContextKey)
 	claims, ok := claimsRaw.(*helper.JWTClaims)
 	if !ok {
-		return &pb.StatusResponse{
-			Status: false,
-		}, status.Error(codes.Internal, "invalid jwt")
+		return nil, status.Error(codes.Unauthenticated, "invalid jwt")
 	}
 
 	ismember, memberId, err := s.chatUsecase.GetGroupMemberID(ctx, claims.UserID, uint(req.GroupId))
 	if err != nil {
-		return &pb.StatusResponse{
-			Status: false,
-		}, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 	if !ismember {
-		return &pb.StatusResponse{
-			Status: false,
-		}, status.Error(codes.Unauthenticated, "you arent member")
+		return nil, apperror.PermissionDenied("you arent member")
 	}
 
 	deleteMembers := helper.ParsingPBtoRemoveMember(req.ListMemberId)
 	if err := s.chatUsecase.RemoveMember(deleteMembers, memberId); err != nil {
-		return &pb.StatusResponse{
-			Status: false,
-		}, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 	return &pb.StatusResponse{
 		Status: true,
@@ -321,27 +250,19 @@ func (s *ChatServer) ExitGroup(ctx context.Context, req *pb.ExitGroupRequest) (*
 	claimsRaw := ctx.Value(interceptor.UserContextKey)
 	claims, ok := claimsRaw.(*helper.JWTClaims)
 	if !ok {
-		return &pb.StatusResponse{
-			Status: false,
-		}, status.Error(codes.Internal, "invalid jwt")
+		return nil, status.Error(codes.Unauthenticated, "invalid jwt")
 	}
 
 	ismember, memberId, err := s.chatUsecase.GetGroupMemberID(ctx, claims.UserID, uint(req.GroupId))
 	if err != nil {
-		return &pb.StatusResponse{
-			Status: false,
-		}, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 	if !ismember {
-		return &pb.StatusResponse{
-			Status: false,
-		}, status.Error(codes.Unauthenticated, "you arent member")
+		return nil, apperror.PermissionDenied("you arent member")
 	}
 
 	if err := s.chatUsecase.ExitGroup(memberId); err != nil {
-		return &pb.StatusResponse{
-			Status: false,
-		}, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
 	return &pb.StatusResponse{
@@ -350,36 +271,22 @@ func (s *ChatServer) ExitGroup(ctx context.Context, req *pb.ExitGroupRequest) (*
 }
 
 func (s *ChatServer) UpdateRoleUser(ctx context.Context, req *pb.UpdateRoleUserRequest) (*pb.StatusResponse, error) {
-	if err := req.Validate(); err != nil {
-		return &pb.StatusResponse{
-			Status: false,
-		}, status.Error(codes.Canceled, "invalid message")
-	}
-
 	claimsRaw := ctx.Value(interceptor.UserContextKey)
 	claims, ok := claimsRaw.(*helper.JWTClaims)
 	if !ok {
-		return &pb.StatusResponse{
-			Status: false,
-		}, status.Error(codes.Internal, "invalid jwt")
+		return nil, status.Error(codes.Unauthenticated, "invalid jwt")
 	}
 
 	ismember, memberId, err := s.chatUsecase.GetGroupMemberID(ctx, claims.UserID, uint(req.GroupId))
 	if err != nil {
-		return &pb.StatusResponse{
-			Status: false,
-		}, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 	if !ismember {
-		return &pb.StatusResponse{
-			Status: false,
-		}, status.Error(codes.Unauthenticated, "you arent member")
+		return nil, apperror.PermissionDenied("you arent member")
 	}
 
 	if err := s.chatUsecase.UpdateRoleUser(memberId, memberId, uint(req.GroupId), req.Role); err != nil {
-		return &pb.StatusResponse{
-			Status: false,
-		}, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
 	return &pb.StatusResponse{
@@ -389,9 +296,6 @@ func (s *ChatServer) UpdateRoleUser(ctx context.Context, req *pb.UpdateRoleUserR
 
 // chat stream
 func (s *ChatServer) ChatStreaming(req *pb.ChatStreamingRequest, stream pb.ChatService_ChatStreamingServer) error {
-	if err := req.Validate(); err != nil {
-		return err
-	}
 	ctx := stream.Context()
 
 	claims, ok := ctx.Value(interceptor.UserContextKey).(*helper.JWTClaims)
@@ -400,22 +304,42 @@ func (s *ChatServer) ChatStreaming(req *pb.ChatStreamingRequest, stream pb.ChatS
 	}
 	ismember, memberId, err := s.chatUsecase.GetGroupMemberID(ctx, claims.UserID, uint(req.GroupId))
 	if err != nil {
-		return status.Error(codes.Internal, "server error")
+		return err
 	}
 	if !ismember {
-		return status.Error(codes.Unauthenticated, "you arent member")
+		return apperror.PermissionDenied("you arent member")
 	}
 
 	if err := s.chatUsecase.UpdateUnreadMessage(memberId); err != nil {
-		return status.Error(codes.Internal, err.Error())
+		return err
+	}
+
+	// subscribe satu thread: event difilter per root thread, history hanya root + balasan
+	threadId := uint(0)
+	if req.ThreadId != 0 {
+		root, _, err := s.chatUsecase.GetThread(ctx, claims.UserID, uint(req.GroupId), uint(req.ThreadId))
+		if err != nil {
+			return err
+		}
+		threadId = root.ID
 	}
 
-	clientId := s.chatUsecase.AddChatStream(stream)
+	// daftar stream dulu baru load history supaya tidak ada event yang terlewat
+	clientId := s.chatUsecase.AddChatStream(uint(req.GroupId), threadId, claims.UserID, stream)
 	defer s.chatUsecase.RemoveChatStream(clientId)
 
-	chatHistory, err := s.chatUsecase.GetChatsByGroupID(uint(req.GroupId))
-	if err != nil {
-		return err
+	var chatHistory []entity.Chat
+	if threadId != 0 {
+		root, replies, err := s.chatUsecase.GetThread(ctx, claims.UserID, uint(req.GroupId), threadId)
+		if err != nil {
+			return err
+		}
+		chatHistory = append([]entity.Chat{*root}, replies...)
+	} else {
+		chatHistory, err = s.chatUsecase.GetChatsByGroupID(claims.UserID, uint(req.GroupId))
+		if err != nil {
+			return err
+		}
 	}
 
 	for _, chat := range chatHistory {
@@ -424,14 +348,7 @@ func (s *ChatServer) ChatStreaming(req *pb.ChatStreamingRequest, stream pb.ChatS
 		case <-ctx.Done():
 			return nil
 		default:
-			readStatus := helper.ConvertChatToPbResponse(&chat)
-			err := stream.Send(&pb.ChatStreamingResponse{
-				ReadStatus: readStatus,
-				Member:     uint64(*chat.GroupMemberID),
-				Username:   chat.GroupMember.User.Username,
-				Message:    chat.Message,
-				Timestamp:  chat.CreatedAt.Format(time.RFC3339),
-			})
+			err := stream.Send(helper.ConvertChatToPbStream(&chat, pb.Action_Create))
 			if err != nil {
 				return status.Errorf(codes.Internal, "error sending chat: %v", err)
 			}
@@ -445,10 +362,6 @@ func (s *ChatServer) ChatStreaming(req *pb.ChatStreamingRequest, stream pb.ChatS
 
 // user status stream
 func (s *ChatServer) StatusStreaming(req *pb.StatusStreamingRequest, stream pb.ChatService_StatusStreamingServer) error {
-	if err := req.Validate(); err != nil {
-		return err
-	}
-
 	ctx := stream.Context()
 
 	claims, ok := ctx.Value(interceptor.UserContextKey).(*helper.JWTClaims)
@@ -457,8 +370,11 @@ func (s *ChatServer) StatusStreaming(req *pb.StatusStreamingRequest, stream pb.C
 	}
 
 	isMember, memberId, err := s.chatUsecase.GetGroupMemberID(ctx, claims.UserID, uint(req.GroupId))
-	if err != nil || !isMember {
-		return status.Error(codes.Unauthenticated, "you arent member")
+	if err != nil {
+		return err
+	}
+	if !isMember {
+		return apperror.PermissionDenied("you arent member")
 	}
 	clientID := s.chatUsecase.AddStatusStream(uint(req.GroupId), memberId, stream)
 	defer s.chatUsecase.RemoveStatusStream(clientID)
@@ -497,7 +413,7 @@ func (s *ChatServer) GetListGroup(ctx context.Context, req *emptypb.Empty) (*pb.
 
 	groups, err := s.chatUsecase.GetListGroup(claims.UserID)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 	response := helper.ParsingDtoGroupToPB(groups)
 	return &pb.GetListGroupResponse{Group: response}, nil
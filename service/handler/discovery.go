@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"chat_api/pb"
+	"chat_api/utils/helper"
+	"chat_api/utils/interceptor"
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ChatServer) SearchPublicGroups(ctx context.Context, req *pb.SearchPublicGroupsRequest) (*pb.SearchPublicGroupsResponse, error) {
+	claims, ok := ctx.Value(interceptor.UserContextKey).(*helper.JWTClaims)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "invalid jwt")
+	}
+
+	groups, total, err := s.chatUsecase.SearchPublicGroups(ctx, claims.UserID, req.Query, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.SearchPublicGroupsResponse{Total: int32(total)}
+	for i := range groups {
+		response.Groups = append(response.Groups, helper.ConvertGroupPreviewToPb(&groups[i]))
+	}
+	return response, nil
+}
+
+func (s *ChatServer) GetGroupPreview(ctx context.Context, req *pb.GetGroupPreviewRequest) (*pb.GetGroupPreviewResponse, error) {
+	claims, ok := ctx.Value(interceptor.UserContextKey).(*helper.JWTClaims)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "invalid jwt")
+	}
+
+	group, err := s.chatUsecase.GetGroupPreview(ctx, claims.UserID, uint(req.GroupId))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetGroupPreviewResponse{
+		Group: helper.ConvertGroupPreviewToPb(group),
+	}, nil
+}
+
+func (s *ChatServer) JoinPublicGroup(ctx context.Context, req *pb.JoinPublicGroupRequest) (*pb.JoinPublicGroupResponse, error) {
+	claims, ok := ctx.Value(interceptor.UserContextKey).(*helper.JWTClaims)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "invalid jwt")
+	}
+
+	member, err := s.chatUsecase.JoinPublicGroup(ctx, claims.UserID, uint(req.GroupId))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.JoinPublicGroupResponse{
+		GroupId:  uint64(member.GroupID),
+		MemberId: uint64(member.ID),
+		Role:     member.Role,
+	}, nil
+}
@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"chat_api/pb"
+	"chat_api/utils/apperror"
+	"chat_api/utils/helper"
+	"chat_api/utils/interceptor"
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ChatServer) ForwardMessages(ctx context.Context, req *pb.ForwardMessagesRequest) (*pb.ForwardMessagesResponse, error) {
+	claims, ok := ctx.Value(interceptor.UserContextKey).(*helper.JWTClaims)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "invalid jwt")
+	}
+
+	ismember, _, err := s.chatUsecase.GetGroupMemberID(ctx, claims.UserID, uint(req.GroupId))
+	if err != nil {
+		return nil, err
+	}
+	if !ismember {
+		return nil, apperror.PermissionDenied("you arent member")
+	}
+
+	var chatIds, targetGroupIds []uint
+	for _, id := range req.ChatIds {
+		chatIds = append(chatIds, uint(id))
+	}
+	for _, id := range req.TargetGroupIds {
+		targetGroupIds = append(targetGroupIds, uint(id))
+	}
+
+	chats, err := s.chatUsecase.ForwardMessages(ctx, claims.UserID, uint(req.GroupId), chatIds, targetGroupIds)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.ForwardMessagesResponse{}
+	for i := range chats {
+		s.chatUsecase.ChatBroadcast(&chats[i], 0)
+		response.Chats = append(response.Chats, &pb.ForwardedChat{
+			GroupId: uint64(chats[i].GroupID),
+			ChatId:  uint64(chats[i].ID),
+		})
+	}
+	return response, nil
+}
@@ -0,0 +1,104 @@
+package handler
+
+import (
+	"chat_api/pb"
+	"chat_api/utils/apperror"
+	"chat_api/utils/helper"
+	"chat_api/utils/interceptor"
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ChatServer) CreateInvite(ctx context.Context, req *pb.CreateInviteRequest) (*pb.CreateInviteResponse, error) {
+	claims, ok := ctx.Value(interceptor.UserContextKey).(*helper.JWTClaims)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "invalid jwt")
+	}
+
+	ismember, memberId, err := s.chatUsecase.GetGroupMemberID(ctx, claims.UserID, uint(req.GroupId))
+	if err != nil {
+		return nil, err
+	}
+	if !ismember {
+		return nil, apperror.PermissionDenied("you arent member")
+	}
+
+	invite, err := s.chatUsecase.CreateInvite(ctx, uint(req.GroupId), memberId, req.Role, int(req.MaxUses), time.Duration(req.ExpiresInSeconds)*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateInviteResponse{
+		Invite: helper.ConvertInviteToPb(invite),
+	}, nil
+}
+
+func (s *ChatServer) ListInvites(ctx context.Context, req *pb.ListInvitesRequest) (*pb.ListInvitesResponse, error) {
+	claims, ok := ctx.Value(interceptor.UserContextKey).(*helper.JWTClaims)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "invalid jwt")
+	}
+
+	ismember, memberId, err := s.chatUsecase.GetGroupMemberID(ctx, claims.UserID, uint(req.GroupId))
+	if err != nil {
+		return nil, err
+	}
+	if !ismember {
+		return nil, apperror.PermissionDenied("you arent member")
+	}
+
+	invites, err := s.chatUsecase.ListInvites(ctx, uint(req.GroupId), memberId)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.ListInvitesResponse{}
+	for i := range invites {
+		response.Invites = append(response.Invites, helper.ConvertInviteToPb(&invites[i]))
+	}
+	return response, nil
+}
+
+func (s *ChatServer) RevokeInvite(ctx context.Context, req *pb.RevokeInviteRequest) (*pb.StatusResponse, error) {
+	claims, ok := ctx.Value(interceptor.UserContextKey).(*helper.JWTClaims)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "invalid jwt")
+	}
+
+	ismember, memberId, err := s.chatUsecase.GetGroupMemberID(ctx, claims.UserID, uint(req.GroupId))
+	if err != nil {
+		return nil, err
+	}
+	if !ismember {
+		return nil, apperror.PermissionDenied("you arent member")
+	}
+
+	if err := s.chatUsecase.RevokeInvite(ctx, uint(req.GroupId), memberId, req.Code); err != nil {
+		return nil, err
+	}
+
+	return &pb.StatusResponse{
+		Status: true,
+	}, nil
+}
+
+func (s *ChatServer) JoinByInvite(ctx context.Context, req *pb.JoinByInviteRequest) (*pb.JoinByInviteResponse, error) {
+	claims, ok := ctx.Value(interceptor.UserContextKey).(*helper.JWTClaims)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "invalid jwt")
+	}
+
+	member, err := s.chatUsecase.JoinByInvite(ctx, claims.UserID, req.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.JoinByInviteResponse{
+		GroupId:  uint64(member.GroupID),
+		MemberId: uint64(member.ID),
+		Role:     member.Role,
+	}, nil
+}
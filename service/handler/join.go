@@ -0,0 +1,100 @@
+package handler
+
+import (
+	"chat_api/pb"
+	"chat_api/utils/apperror"
+	"chat_api/utils/helper"
+	"chat_api/utils/interceptor"
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ChatServer) RequestToJoin(ctx context.Context, req *pb.RequestToJoinRequest) (*pb.RequestToJoinResponse, error) {
+	claims, ok := ctx.Value(interceptor.UserContextKey).(*helper.JWTClaims)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "invalid jwt")
+	}
+
+	joinReq, err := s.chatUsecase.RequestToJoin(ctx, claims.UserID, uint(req.GroupId), req.Note)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RequestToJoinResponse{
+		JoinRequest: helper.ConvertJoinRequestToPb(joinReq),
+	}, nil
+}
+
+func (s *ChatServer) ListJoinRequests(ctx context.Context, req *pb.ListJoinRequestsRequest) (*pb.ListJoinRequestsResponse, error) {
+	claims, ok := ctx.Value(interceptor.UserContextKey).(*helper.JWTClaims)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "invalid jwt")
+	}
+
+	ismember, memberId, err := s.chatUsecase.GetGroupMemberID(ctx, claims.UserID, uint(req.GroupId))
+	if err != nil {
+		return nil, err
+	}
+	if !ismember {
+		return nil, apperror.PermissionDenied("you arent member")
+	}
+
+	joinReqs, err := s.chatUsecase.ListJoinRequests(ctx, uint(req.GroupId), memberId)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.ListJoinRequestsResponse{}
+	for i := range joinReqs {
+		response.JoinRequests = append(response.JoinRequests, helper.ConvertJoinRequestToPb(&joinReqs[i]))
+	}
+	return response, nil
+}
+
+func (s *ChatServer) ApproveJoinRequest(ctx context.Context, req *pb.ApproveJoinRequestRequest) (*pb.StatusResponse, error) {
+	claims, ok := ctx.Value(interceptor.UserContextKey).(*helper.JWTClaims)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "invalid jwt")
+	}
+
+	ismember, memberId, err := s.chatUsecase.GetGroupMemberID(ctx, claims.UserID, uint(req.GroupId))
+	if err != nil {
+		return nil, err
+	}
+	if !ismember {
+		return nil, apperror.PermissionDenied("you arent member")
+	}
+
+	if err := s.chatUsecase.ApproveJoinRequest(ctx, uint(req.GroupId), memberId, uint(req.RequestId)); err != nil {
+		return nil, err
+	}
+
+	return &pb.StatusResponse{
+		Status: true,
+	}, nil
+}
+
+func (s *ChatServer) RejectJoinRequest(ctx context.Context, req *pb.RejectJoinRequestRequest) (*pb.StatusResponse, error) {
+	claims, ok := ctx.Value(interceptor.UserContextKey).(*helper.JWTClaims)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "invalid jwt")
+	}
+
+	ismember, memberId, err := s.chatUsecase.GetGroupMemberID(ctx, claims.UserID, uint(req.GroupId))
+	if err != nil {
+		return nil, err
+	}
+	if !ismember {
+		return nil, apperror.PermissionDenied("you arent member")
+	}
+
+	if err := s.chatUsecase.RejectJoinRequest(ctx, uint(req.GroupId), memberId, uint(req.RequestId)); err != nil {
+		return nil, err
+	}
+
+	return &pb.StatusResponse{
+		Status: true,
+	}, nil
+}
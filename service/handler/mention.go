@@ -0,0 +1,25 @@
+package handler
+
+import (
+	"chat_api/pb"
+	"chat_api/utils/helper"
+	"chat_api/utils/interceptor"
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ChatServer) ListMentions(ctx context.Context, req *pb.ListMentionsRequest) (*pb.ListMentionsResponse, error) {
+	claims, ok := ctx.Value(interceptor.UserContextKey).(*helper.JWTClaims)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "invalid jwt")
+	}
+
+	mentions, err := s.chatUsecase.ListMentions(ctx, claims.UserID, uint(req.GroupId), req.UnreadOnly, int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListMentionsResponse{Mentions: helper.ConvertMentionsToPb(mentions)}, nil
+}
@@ -0,0 +1,90 @@
+package handler
+
+import (
+	"chat_api/pb"
+	"chat_api/utils/apperror"
+	"chat_api/utils/helper"
+	"chat_api/utils/interceptor"
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ChatServer) PinMessage(ctx context.Context, req *pb.PinMessageRequest) (*pb.StatusResponse, error) {
+	claims, ok := ctx.Value(interceptor.UserContextKey).(*helper.JWTClaims)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "invalid jwt")
+	}
+
+	ismember, memberId, err := s.chatUsecase.GetGroupMemberID(ctx, claims.UserID, uint(req.GroupId))
+	if err != nil {
+		return nil, err
+	}
+	if !ismember {
+		return nil, apperror.PermissionDenied("you arent member")
+	}
+
+	chat, err := s.chatUsecase.PinMessage(ctx, uint(req.GroupId), uint(req.ChatId), memberId)
+	if err != nil {
+		return nil, err
+	}
+
+	s.chatUsecase.ChatBroadcast(chat, int(pb.Action_Pin))
+
+	return &pb.StatusResponse{
+		Status: true,
+	}, nil
+}
+
+func (s *ChatServer) UnpinMessage(ctx context.Context, req *pb.UnpinMessageRequest) (*pb.StatusResponse, error) {
+	claims, ok := ctx.Value(interceptor.UserContextKey).(*helper.JWTClaims)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "invalid jwt")
+	}
+
+	ismember, memberId, err := s.chatUsecase.GetGroupMemberID(ctx, claims.UserID, uint(req.GroupId))
+	if err != nil {
+		return nil, err
+	}
+	if !ismember {
+		return nil, apperror.PermissionDenied("you arent member")
+	}
+
+	chat, err := s.chatUsecase.UnpinMessage(ctx, uint(req.GroupId), uint(req.ChatId), memberId)
+	if err != nil {
+		return nil, err
+	}
+
+	s.chatUsecase.ChatBroadcast(chat, int(pb.Action_Unpin))
+
+	return &pb.StatusResponse{
+		Status: true,
+	}, nil
+}
+
+func (s *ChatServer) ListPinnedMessages(ctx context.Context, req *pb.ListPinnedMessagesRequest) (*pb.ListPinnedMessagesResponse, error) {
+	claims, ok := ctx.Value(interceptor.UserContextKey).(*helper.JWTClaims)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "invalid jwt")
+	}
+
+	ismember, _, err := s.chatUsecase.GetGroupMemberID(ctx, claims.UserID, uint(req.GroupId))
+	if err != nil {
+		return nil, err
+	}
+	if !ismember {
+		return nil, apperror.PermissionDenied("you arent member")
+	}
+
+	chats, err := s.chatUsecase.ListPinnedMessages(ctx, claims.UserID, uint(req.GroupId))
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.ListPinnedMessagesResponse{}
+	for i := range chats {
+		response.Chats = append(response.Chats, helper.ConvertChatToPbStream(&chats[i], pb.Action_Create))
+	}
+	return response, nil
+}
@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"chat_api/pb"
+	"chat_api/utils/apperror"
+	"chat_api/utils/helper"
+	"chat_api/utils/interceptor"
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ChatServer) AddReaction(ctx context.Context, req *pb.AddReactionRequest) (*pb.StatusResponse, error) {
+	claims, ok := ctx.Value(interceptor.UserContextKey).(*helper.JWTClaims)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "invalid jwt")
+	}
+
+	ismember, memberId, err := s.chatUsecase.GetGroupMemberID(ctx, claims.UserID, uint(req.GroupId))
+	if err != nil {
+		return nil, err
+	}
+	if !ismember {
+		return nil, apperror.PermissionDenied("you arent member")
+	}
+
+	chat, err := s.chatUsecase.AddReaction(ctx, uint(req.GroupId), uint(req.ChatId), memberId, req.Emoji)
+	if err != nil {
+		return nil, err
+	}
+
+	s.chatUsecase.ReactionBroadcast(chat, memberId)
+
+	return &pb.StatusResponse{
+		Status: true,
+	}, nil
+}
+
+func (s *ChatServer) RemoveReaction(ctx context.Context, req *pb.RemoveReactionRequest) (*pb.StatusResponse, error) {
+	claims, ok := ctx.Value(interceptor.UserContextKey).(*helper.JWTClaims)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "invalid jwt")
+	}
+
+	ismember, memberId, err := s.chatUsecase.GetGroupMemberID(ctx, claims.UserID, uint(req.GroupId))
+	if err != nil {
+		return nil, err
+	}
+	if !ismember {
+		return nil, apperror.PermissionDenied("you arent member")
+	}
+
+	chat, err := s.chatUsecase.RemoveReaction(ctx, uint(req.GroupId), uint(req.ChatId), memberId, req.Emoji)
+	if err != nil {
+		return nil, err
+	}
+
+	s.chatUsecase.ReactionBroadcast(chat, memberId)
+
+	return &pb.StatusResponse{
+		Status: true,
+	}, nil
+}
@@ -0,0 +1,38 @@
+package handler
+
+import (
+	"chat_api/pb"
+	"chat_api/utils/apperror"
+	"chat_api/utils/helper"
+	"chat_api/utils/interceptor"
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ChatServer) GetMessageRevisions(ctx context.Context, req *pb.GetMessageRevisionsRequest) (*pb.GetMessageRevisionsResponse, error) {
+	claims, ok := ctx.Value(interceptor.UserContextKey).(*helper.JWTClaims)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "invalid jwt")
+	}
+
+	ismember, memberId, err := s.chatUsecase.GetGroupMemberID(ctx, claims.UserID, uint(req.GroupId))
+	if err != nil {
+		return nil, err
+	}
+	if !ismember {
+		return nil, apperror.PermissionDenied("you arent member")
+	}
+
+	chat, revisions, err := s.chatUsecase.GetMessageRevisions(ctx, claims.UserID, uint(req.GroupId), uint(req.ChatId), memberId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetMessageRevisionsResponse{
+		Chat:           helper.ConvertChatToPbStream(chat, pb.Action_Update),
+		CurrentMessage: chat.Message,
+		Revisions:      helper.ConvertRevisionsToPb(revisions),
+	}, nil
+}
@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"chat_api/pb"
+	"chat_api/utils/apperror"
+	"chat_api/utils/helper"
+	"chat_api/utils/interceptor"
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ChatServer) ScheduleMessage(ctx context.Context, req *pb.ScheduleMessageRequest) (*pb.ScheduleMessageResponse, error) {
+	claims, ok := ctx.Value(interceptor.UserContextKey).(*helper.JWTClaims)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "invalid jwt")
+	}
+
+	ismember, memberId, err := s.chatUsecase.GetGroupMemberID(ctx, claims.UserID, uint(req.GroupId))
+	if err != nil {
+		return nil, err
+	}
+	if !ismember {
+		return nil, apperror.PermissionDenied("you arent member")
+	}
+
+	sendAt, err := time.Parse(time.RFC3339, req.SendAt)
+	if err != nil {
+		return nil, apperror.Invalid("invalid send_at", apperror.FieldViolation{
+			Field:       "send_at",
+			Description: "value must be an RFC3339 timestamp",
+		})
+	}
+
+	msg, err := s.chatUsecase.ScheduleMessage(ctx, uint(req.GroupId), memberId, claims.UserID, req.Message, sendAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ScheduleMessageResponse{
+		ScheduledMessage: helper.ConvertScheduledMessageToPb(msg),
+	}, nil
+}
+
+func (s *ChatServer) ListScheduledMessages(ctx context.Context, req *pb.ListScheduledMessagesRequest) (*pb.ListScheduledMessagesResponse, error) {
+	claims, ok := ctx.Value(interceptor.UserContextKey).(*helper.JWTClaims)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "invalid jwt")
+	}
+
+	msgs, err := s.chatUsecase.ListScheduledMessages(ctx, claims.UserID, uint(req.GroupId))
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.ListScheduledMessagesResponse{}
+	for i := range msgs {
+		response.ScheduledMessages = append(response.ScheduledMessages, helper.ConvertScheduledMessageToPb(&msgs[i]))
+	}
+	return response, nil
+}
+
+func (s *ChatServer) CancelScheduledMessage(ctx context.Context, req *pb.CancelScheduledMessageRequest) (*pb.StatusResponse, error) {
+	claims, ok := ctx.Value(interceptor.UserContextKey).(*helper.JWTClaims)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "invalid jwt")
+	}
+
+	if err := s.chatUsecase.CancelScheduledMessage(ctx, uint(req.Id), claims.UserID); err != nil {
+		return nil, err
+	}
+
+	return &pb.StatusResponse{
+		Status: true,
+	}, nil
+}
@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"chat_api/pb"
+	"chat_api/utils/apperror"
+	"chat_api/utils/helper"
+	"chat_api/utils/interceptor"
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ChatServer) SearchMessages(ctx context.Context, req *pb.SearchMessagesRequest) (*pb.SearchMessagesResponse, error) {
+	claims, ok := ctx.Value(interceptor.UserContextKey).(*helper.JWTClaims)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "invalid jwt")
+	}
+
+	if req.GroupId != 0 {
+		ismember, _, err := s.chatUsecase.GetGroupMemberID(ctx, claims.UserID, uint(req.GroupId))
+		if err != nil {
+			return nil, err
+		}
+		if !ismember {
+			return nil, apperror.PermissionDenied("you arent member")
+		}
+	}
+
+	searchReq, err := helper.ParsingPbToSearchMessages(req, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	hits, total, err := s.chatUsecase.SearchMessages(ctx, searchReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SearchMessagesResponse{
+		Hits:  helper.ConvertSearchHitsToPb(hits),
+		Total: int32(total),
+	}, nil
+}
@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"chat_api/pb"
+	"chat_api/utils/apperror"
+	"chat_api/utils/helper"
+	"chat_api/utils/interceptor"
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ChatServer) ListThread(ctx context.Context, req *pb.ListThreadRequest) (*pb.ListThreadResponse, error) {
+	claims, ok := ctx.Value(interceptor.UserContextKey).(*helper.JWTClaims)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "invalid jwt")
+	}
+
+	ismember, _, err := s.chatUsecase.GetGroupMemberID(ctx, claims.UserID, uint(req.GroupId))
+	if err != nil {
+		return nil, err
+	}
+	if !ismember {
+		return nil, apperror.PermissionDenied("you arent member")
+	}
+
+	root, replies, err := s.chatUsecase.GetThread(ctx, claims.UserID, uint(req.GroupId), uint(req.ChatId))
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.ListThreadResponse{
+		Root: helper.ConvertChatToPbStream(root, pb.Action_Create),
+	}
+	for i := range replies {
+		response.Replies = append(response.Replies, helper.ConvertChatToPbStream(&replies[i], pb.Action_Create))
+	}
+	return response, nil
+}
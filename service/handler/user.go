@@ -0,0 +1,152 @@
+package handler
+
+import (
+	"chat_api/pb"
+	"chat_api/service/usecase"
+	"chat_api/utils/helper"
+	"chat_api/utils/interceptor"
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type UserServer struct {
+	pb.UnimplementedUserServiceServer
+	userUsecase usecase.UserUsecase
+}
+
+func NewUserHandler(userUsecase usecase.UserUsecase) *UserServer {
+	return &UserServer{userUsecase: userUsecase}
+}
+
+func (s *UserServer) GetMe(ctx context.Context, req *emptypb.Empty) (*pb.GetMeResponse, error) {
+	claims, ok := ctx.Value(interceptor.UserContextKey).(*helper.JWTClaims)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "invalid jwt")
+	}
+
+	user, err := s.userUsecase.GetMe(ctx, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetMeResponse{
+		User:         helper.ConvertUserToPb(user),
+		Email:        user.Email,
+		Discoverable: user.Discoverable,
+	}, nil
+}
+
+func (s *UserServer) UpdateProfile(ctx context.Context, req *pb.UpdateProfileRequest) (*pb.UpdateProfileResponse, error) {
+	claims, ok := ctx.Value(interceptor.UserContextKey).(*helper.JWTClaims)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "invalid jwt")
+	}
+
+	user, err := s.userUsecase.UpdateProfile(ctx, claims.UserID, helper.ParsingPbToUpdateProfile(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateProfileResponse{
+		User: helper.ConvertUserToPb(user),
+	}, nil
+}
+
+func (s *UserServer) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.GetUserResponse, error) {
+	if _, ok := ctx.Value(interceptor.UserContextKey).(*helper.JWTClaims); !ok {
+		return nil, status.Error(codes.Unauthenticated, "invalid jwt")
+	}
+
+	user, err := s.userUsecase.GetUser(ctx, uint(req.UserId))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetUserResponse{
+		User: helper.ConvertUserToPb(user),
+	}, nil
+}
+
+func (s *UserServer) GetUsers(ctx context.Context, req *pb.GetUsersRequest) (*pb.GetUsersResponse, error) {
+	if _, ok := ctx.Value(interceptor.UserContextKey).(*helper.JWTClaims); !ok {
+		return nil, status.Error(codes.Unauthenticated, "invalid jwt")
+	}
+
+	userIds := make([]uint, len(req.UserIds))
+	for i, id := range req.UserIds {
+		userIds[i] = uint(id)
+	}
+	users, err := s.userUsecase.GetUsers(ctx, userIds)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.GetUsersResponse{}
+	for i := range users {
+		response.Users = append(response.Users, helper.ConvertUserToPb(&users[i]))
+	}
+	return response, nil
+}
+
+func (s *UserServer) SearchUsers(ctx context.Context, req *pb.SearchUsersRequest) (*pb.SearchUsersResponse, error) {
+	claims, ok := ctx.Value(interceptor.UserContextKey).(*helper.JWTClaims)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "invalid jwt")
+	}
+
+	users, total, err := s.userUsecase.SearchUsers(ctx, claims.UserID, req.Query, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.SearchUsersResponse{Total: int32(total)}
+	for i := range users {
+		response.Users = append(response.Users, helper.ConvertUserToPb(&users[i]))
+	}
+	return response, nil
+}
+
+func (s *UserServer) BlockUser(ctx context.Context, req *pb.BlockUserRequest) (*emptypb.Empty, error) {
+	claims, ok := ctx.Value(interceptor.UserContextKey).(*helper.JWTClaims)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "invalid jwt")
+	}
+
+	if _, err := s.userUsecase.BlockUser(ctx, claims.UserID, uint(req.UserId)); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *UserServer) UnblockUser(ctx context.Context, req *pb.BlockUserRequest) (*emptypb.Empty, error) {
+	claims, ok := ctx.Value(interceptor.UserContextKey).(*helper.JWTClaims)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "invalid jwt")
+	}
+
+	if err := s.userUsecase.UnblockUser(ctx, claims.UserID, uint(req.UserId)); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *UserServer) ListBlockedUsers(ctx context.Context, req *emptypb.Empty) (*pb.ListBlockedUsersResponse, error) {
+	claims, ok := ctx.Value(interceptor.UserContextKey).(*helper.JWTClaims)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "invalid jwt")
+	}
+
+	blocks, err := s.userUsecase.ListBlockedUsers(ctx, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.ListBlockedUsersResponse{}
+	for i := range blocks {
+		response.Users = append(response.Users, helper.ConvertUserBlockToPb(&blocks[i]))
+	}
+	return response, nil
+}
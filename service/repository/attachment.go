@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"chat_api/entity"
+	"chat_api/utils/apperror"
+	"context"
+)
+
+func (r *chatRepo) CreateAttachment(ctx context.Context, attachment *entity.Attachment) error {
+	err := r.db.WithContext(ctx).Create(attachment).Error
+	return apperror.FromDB(err, "group not found")
+}
+
+func (r *chatRepo) GetAttachment(ctx context.Context, attachmentId uint) (*entity.Attachment, error) {
+	var attachment entity.Attachment
+	if err := r.db.WithContext(ctx).Preload("Thumbnails").Where("id = ?", attachmentId).First(&attachment).Error; err != nil {
+		return nil, apperror.FromDB(err, "attachment not found")
+	}
+	return &attachment, nil
+}
+
+// UpdateAttachmentMedia stores the result of the image pipeline. Size and
+// checksum change when location data was stripped from the original.
+func (r *chatRepo) UpdateAttachmentMedia(ctx context.Context, attachment *entity.Attachment, thumbnails []entity.AttachmentThumbnail) error {
+	tx := r.db.WithContext(ctx).Begin()
+
+	if err := tx.Model(&entity.Attachment{}).Where("id = ?", attachment.ID).Updates(map[string]interface{}{
+		"size":     attachment.Size,
+		"checksum": attachment.Checksum,
+		"width":    attachment.Width,
+		"height":   attachment.Height,
+		"blurhash": attachment.Blurhash,
+		"status":   attachment.Status,
+	}).Error; err != nil {
+		tx.Rollback()
+		return apperror.FromDB(err, "attachment not found")
+	}
+
+	if len(thumbnails) > 0 {
+		if err := tx.Create(&thumbnails).Error; err != nil {
+			tx.Rollback()
+			return apperror.FromDB(err, "attachment not found")
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return apperror.FromDB(err, "attachment not found")
+	}
+	attachment.Thumbnails = thumbnails
+	return nil
+}
+
+func (r *chatRepo) UpdateAttachmentStatus(ctx context.Context, attachmentId uint, status string) error {
+	err := r.db.WithContext(ctx).Model(&entity.Attachment{}).Where("id = ?", attachmentId).Update("status", status).Error
+	return apperror.FromDB(err, "attachment not found")
+}
+
+func (r *chatRepo) GetProcessingAttachmentIDs(ctx context.Context) ([]uint, error) {
+	var ids []uint
+	if err := r.db.WithContext(ctx).Model(&entity.Attachment{}).Where("status = ?", entity.AttachmentProcessing).Pluck("id", &ids).Error; err != nil {
+		return nil, apperror.FromDB(err, "attachment not found")
+	}
+	return ids, nil
+}
+
+func (r *chatRepo) IsAvatarAttachment(ctx context.Context, attachmentId uint) (bool, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&entity.User{}).Where("avatar_attachment_id = ?", attachmentId).Count(&count).Error; err != nil {
+		return false, apperror.FromDB(err, "user not found")
+	}
+	return count > 0, nil
+}
@@ -2,7 +2,9 @@ package repository
 
 import (
 	"chat_api/entity"
+	"chat_api/utils/apperror"
 	"context"
+	"errors"
 
 	"gorm.io/gorm"
 )
@@ -24,7 +26,7 @@ func (r *authRepository) Login(ctx context.Context, user *entity.User) (string,
 
 	var finduser entity.User
 	if err := r.db.Model(&entity.User{}).Where("email = ?", user.Email).First(&finduser).Error; err != nil {
-		return "", 0, err
+		return "", 0, apperror.FromDB(err, "user not found")
 
 	}
 
@@ -39,7 +41,10 @@ func (r *authRepository) Register(ctx context.Context, user *entity.User) error
 	}
 
 	if err := r.db.Create(&createuser).Error; err != nil {
-		return err
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return apperror.AlreadyExists("email atau username sudah terdaftar")
+		}
+		return apperror.FromDB(err, "user not found")
 	}
 
 	return nil
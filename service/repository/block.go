@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"chat_api/entity"
+	"chat_api/utils/apperror"
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+func (r *userRepository) BlockUser(ctx context.Context, userId, blockedUserId uint) (*entity.UserBlock, error) {
+	if _, err := r.GetUser(ctx, blockedUserId); err != nil {
+		return nil, err
+	}
+
+	block := entity.UserBlock{UserID: userId, BlockedUserID: blockedUserId}
+	if err := r.db.WithContext(ctx).Create(&block).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, apperror.AlreadyExists("user already blocked")
+		}
+		return nil, apperror.FromDB(err, "user not found")
+	}
+	return &block, nil
+}
+
+func (r *userRepository) UnblockUser(ctx context.Context, userId, blockedUserId uint) error {
+	res := r.db.WithContext(ctx).
+		Where("user_id = ? AND blocked_user_id = ?", userId, blockedUserId).
+		Delete(&entity.UserBlock{})
+	if res.Error != nil {
+		return apperror.FromDB(res.Error, "user is not blocked")
+	}
+	if res.RowsAffected == 0 {
+		return apperror.NotFound("user is not blocked")
+	}
+	return nil
+}
+
+// ListBlockedUsers returns the blocks of userId, newest first
+func (r *userRepository) ListBlockedUsers(ctx context.Context, userId uint) ([]entity.UserBlock, error) {
+	var blocks []entity.UserBlock
+	err := r.db.WithContext(ctx).
+		Preload("BlockedUser").
+		Where("user_id = ?", userId).
+		Order("created_at DESC").Order("id DESC").
+		Find(&blocks).Error
+	return blocks, apperror.FromDB(err, "user not found")
+}
+
+// GetBlockerUserIDs returns the users that blocked the user behind memberId
+func (r *chatRepo) GetBlockerUserIDs(ctx context.Context, memberId uint) ([]uint, error) {
+	var ids []uint
+	err := r.db.WithContext(ctx).Model(&entity.UserBlock{}).
+		Joins("JOIN group_members ON group_members.user_id = user_blocks.blocked_user_id").
+		Where("group_members.id = ?", memberId).
+		Pluck("user_blocks.user_id", &ids).Error
+	return ids, apperror.FromDB(err, "member not found")
+}
+
+// GetBlockedMemberIDs returns the member ids of users blocked by userId,
+// limited to groupId unless it is 0
+func (r *chatRepo) GetBlockedMemberIDs(ctx context.Context, userId, groupId uint) ([]uint, error) {
+	db := r.db.WithContext(ctx).Model(&entity.GroupMember{}).
+		Joins("JOIN user_blocks ON user_blocks.blocked_user_id = group_members.user_id").
+		Where("user_blocks.user_id = ?", userId)
+	if groupId != 0 {
+		db = db.Where("group_members.group_id = ?", groupId)
+	}
+
+	var ids []uint
+	err := db.Pluck("group_members.id", &ids).Error
+	return ids, apperror.FromDB(err, "member not found")
+}
+
+// GetBlockersAmong returns which of userIds blocked the user behind memberId
+func (r *chatRepo) GetBlockersAmong(ctx context.Context, memberId uint, userIds []uint) ([]uint, error) {
+	var ids []uint
+	err := r.db.WithContext(ctx).Model(&entity.UserBlock{}).
+		Where("user_blocks.user_id IN ?", userIds).
+		Where("user_blocks.blocked_user_id = (?)", r.db.Model(&entity.GroupMember{}).Select("user_id").Where("id = ?", memberId)).
+		Pluck("user_blocks.user_id", &ids).Error
+	return ids, apperror.FromDB(err, "member not found")
+}
@@ -2,9 +2,11 @@ package repository
 
 import (
 	"chat_api/entity"
+	"chat_api/utils/apperror"
 	"chat_api/utils/helper"
 	"context"
 	"errors"
+	"time"
 
 	"gorm.io/gorm"
 )
@@ -12,12 +14,13 @@ import (
 type ChatRepo interface {
 	//write chat
 	CreateChat(ctx context.Context, req *helper.CreateChatReq) (*entity.Chat, error)
-	DeleteChat(ctx context.Context, chatId uint) (*entity.Chat, error)
-	UpdateChat(ctx context.Context, chatId uint, message string) (*entity.Chat, error)
+	DeleteChat(ctx context.Context, chatId, deletedBy uint) (*entity.Chat, error)
+	PurgeChat(ctx context.Context, chatId uint) (*entity.Chat, error)
+	UpdateChat(ctx context.Context, chatId, editorId uint, message string, mentionIds []uint) (*entity.Chat, error)
 
 	//write group & member
-	CreateGroup(ctx context.Context, name, desc string, userId uint) error
-	UpdateGroup(ctx context.Context, name, desc string, groupId uint) error
+	CreateGroup(ctx context.Context, name, desc, visibility string, userId uint) error
+	UpdateGroup(ctx context.Context, name, desc string, groupId uint, messageTTL *int64, visibility *string) error
 	DeleteGroup(ctx context.Context, groupId uint) error
 	AddMember(req []entity.GroupMember) error
 	RemoveMember(req []uint) error
@@ -26,10 +29,76 @@ type ChatRepo interface {
 
 	IsMemberAdmin(memberId uint) (bool, error)
 	GetGroupMemberID(ctx context.Context, userID, groupID uint) (bool, uint, error)
+	GetUserMemberships(ctx context.Context, userId uint) ([]entity.GroupMember, error)
 	GetChatsByGroupID(groupID uint) ([]entity.Chat, error)
+	GetChat(ctx context.Context, chatId uint) (*entity.Chat, error)
+	GetGroup(ctx context.Context, groupId uint) (*entity.ChatGroup, error)
+	GetThreadReplies(ctx context.Context, rootId uint) ([]entity.Chat, error)
+	GetChatRevisions(ctx context.Context, chatId uint) ([]entity.ChatRevision, error)
+	ListMentions(ctx context.Context, userId, groupId uint, unreadOnly bool, limit int) ([]entity.Mention, error)
 	GetMemberGroup(groupId uint) ([]helper.MemberChat, error)
 	UpdateUnreadMessage(memberId uint) error
 	GetListGroup(userId uint) ([]helper.GroupInfo, error)
+
+	//attachment
+	CreateAttachment(ctx context.Context, attachment *entity.Attachment) error
+	GetAttachment(ctx context.Context, attachmentId uint) (*entity.Attachment, error)
+	UpdateAttachmentMedia(ctx context.Context, attachment *entity.Attachment, thumbnails []entity.AttachmentThumbnail) error
+	UpdateAttachmentStatus(ctx context.Context, attachmentId uint, status string) error
+	GetProcessingAttachmentIDs(ctx context.Context) ([]uint, error)
+	IsAvatarAttachment(ctx context.Context, attachmentId uint) (bool, error)
+
+	//reaction
+	AddReaction(ctx context.Context, reaction *entity.Reaction, maxDistinct int) error
+	RemoveReaction(ctx context.Context, chatId, memberId uint, emoji string) error
+	GetReactions(ctx context.Context, chatId uint) ([]entity.Reaction, error)
+
+	//discovery
+	SearchPublicGroups(ctx context.Context, userId uint, query string, limit, offset int) ([]helper.GroupPreview, int64, error)
+	GetGroupPreview(ctx context.Context, userId, groupId uint) (*helper.GroupPreview, error)
+	JoinPublicGroup(ctx context.Context, groupId, userId uint) (*entity.GroupMember, error)
+
+	//invite
+	CreateInvite(ctx context.Context, invite *entity.GroupInvite) error
+	ListInvites(ctx context.Context, groupId uint) ([]entity.GroupInvite, error)
+	RevokeInvite(ctx context.Context, groupId uint, code string) error
+	JoinByInvite(ctx context.Context, code string, userId uint) (*entity.GroupMember, error)
+
+	//join request
+	CreateJoinRequest(ctx context.Context, req *entity.JoinRequest) error
+	ListJoinRequests(ctx context.Context, groupId uint) ([]entity.JoinRequest, error)
+	ReviewJoinRequest(ctx context.Context, groupId, requestId, reviewerId uint, approve bool) (*entity.JoinRequest, *entity.GroupMember, error)
+	GetGroupAdminIDs(ctx context.Context, groupId uint) ([]uint, error)
+
+	//disappearing message
+	GetExpiredChatIDs(ctx context.Context, now time.Time, afterId uint, limit int) ([]uint, error)
+
+	//pin
+	PinChat(ctx context.Context, pin *entity.Pin, maxPins int) error
+	UnpinChat(ctx context.Context, chatId uint) error
+	GetPinnedChats(ctx context.Context, groupId uint) ([]entity.Chat, error)
+
+	//search
+	GetChatsForIndex(ctx context.Context, afterId uint, limit int) ([]entity.Chat, error)
+	GetChatsByIDs(ctx context.Context, ids []uint) ([]entity.Chat, error)
+	GetUserGroupIDs(ctx context.Context, userId uint) ([]uint, error)
+
+	//forward
+	ForwardChats(ctx context.Context, chats []entity.Chat, readers map[uint][]helper.StatusUser) ([]entity.Chat, error)
+
+	//scheduled message
+	CreateScheduledMessage(ctx context.Context, msg *entity.ScheduledMessage) error
+	ListScheduledMessages(ctx context.Context, userId, groupId uint) ([]entity.ScheduledMessage, error)
+	CancelScheduledMessage(ctx context.Context, id, userId uint) error
+	GetDueScheduledMessages(ctx context.Context, now time.Time, limit int) ([]entity.ScheduledMessage, error)
+	ClaimScheduledMessage(ctx context.Context, id uint) (bool, error)
+	FinishScheduledMessage(ctx context.Context, id uint, status string, chatId *uint, errMsg string) error
+	RequeueSendingScheduledMessages(ctx context.Context) error
+
+	//block
+	GetBlockerUserIDs(ctx context.Context, memberId uint) ([]uint, error)
+	GetBlockedMemberIDs(ctx context.Context, userId, groupId uint) ([]uint, error)
+	GetBlockersAmong(ctx context.Context, memberId uint, userIds []uint) ([]uint, error)
 }
 
 type chatRepo struct {
@@ -40,6 +109,18 @@ func NewChatRepo(db *gorm.DB) ChatRepo {
 	return &chatRepo{db}
 }
 
+// preloadChat loads everything needed to turn a chat into a stream event
+func preloadChat(db *gorm.DB) *gorm.DB {
+	return db.Preload("GroupMember.User").
+		Preload("ReadStatus").
+		Preload("Attachments.Thumbnails").
+		Preload("Reactions", func(db *gorm.DB) *gorm.DB { return db.Order("id") }).
+		Preload("ReplyTo", func(db *gorm.DB) *gorm.DB { return db.Unscoped() }).
+		Preload("ReplyTo.GroupMember.User").
+		Preload("Mentions").
+		Preload("Pin")
+}
+
 // write chat
 func (r *chatRepo) CreateChat(ctx context.Context, req *helper.CreateChatReq) (*entity.Chat, error) {
 	tx := r.db.WithContext(ctx).Begin()
@@ -49,7 +130,71 @@ func (r *chatRepo) CreateChat(ctx context.Context, req *helper.CreateChatReq) (*
 		Message:       req.Message,
 		GroupID:       req.GroupId,
 	}
+
+	if req.ReplyToID != 0 {
+		var parent entity.Chat
+		if err := tx.Select("id", "group_id", "thread_root_id").Where("id = ? AND group_id = ?", req.ReplyToID, req.GroupId).First(&parent).Error; err != nil {
+			tx.Rollback()
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, apperror.Invalid("reply target not found", apperror.FieldViolation{
+					Field:       "reply_to_id",
+					Description: "chat does not exist in this group",
+				})
+			}
+			return nil, apperror.FromDB(err, "chat not found")
+		}
+
+		// balasan dari balasan tetap masuk thread root yang sama
+		rootId := parent.ID
+		if parent.ThreadRootID != nil {
+			rootId = *parent.ThreadRootID
+		}
+		chat.ReplyToID = &parent.ID
+		chat.ThreadRootID = &rootId
+	}
+
+	expiresAt, err := messageExpiry(tx, req.GroupId)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	chat.ExpiresAt = expiresAt
+
 	if err := tx.Create(&chat).Error; err != nil {
+		tx.Rollback()
+		return nil, apperror.FromDB(err, "group not found")
+	}
+
+	if chat.ThreadRootID != nil {
+		if err := refreshThreadStats(tx, *chat.ThreadRootID); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	if len(req.AttachmentIDs) > 0 {
+		// hanya attachment milik pengirim di grup yang sama, sudah selesai diproses dan
+		// belum dipakai chat lain. Avatar tidak boleh dipakai karena blob-nya ikut
+		// terhapus saat chat di-purge
+		res := tx.Model(&entity.Attachment{}).
+			Where("id IN ? AND group_id = ? AND group_member_id = ? AND chat_id IS NULL", req.AttachmentIDs, req.GroupId, req.MemberId).
+			Where("status = ?", entity.AttachmentReady).
+			Where("id NOT IN (?)", tx.Model(&entity.User{}).Select("avatar_attachment_id").Where("avatar_attachment_id IS NOT NULL")).
+			Update("chat_id", chat.ID)
+		if res.Error != nil {
+			tx.Rollback()
+			return nil, apperror.FromDB(res.Error, "attachment not found")
+		}
+		if res.RowsAffected != int64(len(req.AttachmentIDs)) {
+			tx.Rollback()
+			return nil, apperror.Invalid("attachment not found or already used", apperror.FieldViolation{
+				Field:       "attachment_ids",
+				Description: "every attachment must be uploaded by you to this group, finished processing and not yet sent",
+			})
+		}
+	}
+
+	if err := replaceMentions(tx, chat.ID, req.MentionIDs); err != nil {
 		tx.Rollback()
 		return nil, err
 	}
@@ -70,62 +215,190 @@ func (r *chatRepo) CreateChat(ctx context.Context, req *helper.CreateChatReq) (*
 	if len(chatRead) > 0 {
 		if err := tx.CreateInBatches(&chatRead, 10).Error; err != nil {
 			tx.Rollback()
-			return nil, err
+			return nil, apperror.FromDB(err, "member not found")
 		}
 	}
 
 	if err := tx.Model(&entity.ChatGroup{}).
 		Where("id = ?", req.GroupId).
-		Update("last_message", req.Message).Error; err != nil {
+		Update("last_message", lastMessage(req.Message, len(req.AttachmentIDs) > 0)).Error; err != nil {
 		tx.Rollback()
-		return nil, err
+		return nil, apperror.FromDB(err, "group not found")
 	}
 
 	if err := tx.Commit().Error; err != nil {
-		return nil, err
+		return nil, apperror.FromDB(err, "chat not found")
+	}
+
+	// dimuat ulang supaya event stream langsung berisi username, read status dan attachment
+	if err := r.db.WithContext(ctx).Scopes(preloadChat).Where("id = ?", chat.ID).First(&chat).Error; err != nil {
+		return nil, apperror.FromDB(err, "chat not found")
 	}
 
 	return &chat, nil
 
 }
 
-func (r *chatRepo) DeleteChat(ctx context.Context, chatId uint) (*entity.Chat, error) {
-	var chat entity.Chat
-	if err := r.db.Preload("GroupMember.User").Preload("ReadStatus").Where("id = ?", chatId).First(&chat).Error; err != nil {
+// DeleteChat soft-deletes a chat. The row stays as a tombstone for history
+// replay, while its pin, mentions and unread state are cleared.
+func (r *chatRepo) DeleteChat(ctx context.Context, chatId, deletedBy uint) (*entity.Chat, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var chat entity.Chat
+		if err := tx.Select("id", "group_id", "thread_root_id").Where("id = ?", chatId).First(&chat).Error; err != nil {
+			return apperror.FromDB(err, "chat not found")
+		}
+
+		if err := tx.Model(&entity.Chat{}).Where("id = ?", chatId).Updates(map[string]interface{}{
+			"deleted_at":    time.Now(),
+			"deleted_by_id": deletedBy,
+		}).Error; err != nil {
+			return apperror.FromDB(err, "chat not found")
+		}
+
+		if err := tx.Where("chat_id = ?", chatId).Delete(&entity.Pin{}).Error; err != nil {
+			return apperror.FromDB(err, "chat not found")
+		}
+		if err := tx.Where("chat_id = ?", chatId).Delete(&entity.Mention{}).Error; err != nil {
+			return apperror.FromDB(err, "chat not found")
+		}
+		if err := tx.Model(&entity.ChatRead{}).Where("chat_id = ? AND is_read = ?", chatId, false).Update("is_read", true).Error; err != nil {
+			return apperror.FromDB(err, "chat not found")
+		}
+
+		if chat.ThreadRootID != nil {
+			if err := refreshThreadStats(tx, *chat.ThreadRootID); err != nil {
+				return err
+			}
+		}
+		return refreshLastMessage(tx, chat.GroupID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetChat(ctx, chatId)
+}
+
+// PurgeChat removes a chat (deleted or not) permanently, together with its
+// read status, attachments, reactions and mentions.
+func (r *chatRepo) PurgeChat(ctx context.Context, chatId uint) (*entity.Chat, error) {
+	chat, err := r.GetChat(ctx, chatId)
+	if err != nil {
 		return nil, err
 	}
 
-	if err := r.db.Model(&entity.Chat{}).WithContext(ctx).Where("id = ?", chatId).Delete(&entity.Chat{}).Error; err != nil {
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where("id = ?", chatId).Delete(&entity.Chat{}).Error; err != nil {
+			return apperror.FromDB(err, "chat not found")
+		}
+
+		// pengganti ON DELETE SET NULL untuk reply_to_id dan thread_root_id
+		if err := tx.Unscoped().Model(&entity.Chat{}).Where("reply_to_id = ?", chatId).Update("reply_to_id", nil).Error; err != nil {
+			return apperror.FromDB(err, "chat not found")
+		}
+		if err := tx.Unscoped().Model(&entity.Chat{}).Where("thread_root_id = ?", chatId).Update("thread_root_id", nil).Error; err != nil {
+			return apperror.FromDB(err, "chat not found")
+		}
+
+		if chat.ThreadRootID != nil {
+			if err := refreshThreadStats(tx, *chat.ThreadRootID); err != nil {
+				return err
+			}
+		}
+		return refreshLastMessage(tx, chat.GroupID)
+	})
+	if err != nil {
 		return nil, err
 	}
 
-	return &chat, nil
+	return chat, nil
+}
+
+// lastMessage is the group preview text; chats with only attachments show
+// a placeholder instead of an empty string.
+func lastMessage(message string, hasAttachment bool) string {
+	if message == "" && hasAttachment {
+		return "📎 attachment"
+	}
+	return message
+}
+
+// refreshLastMessage recomputes the group preview from the latest chat that
+// is not deleted, after that chat was edited or deleted.
+func refreshLastMessage(tx *gorm.DB, groupId uint) error {
+	preview := ""
+
+	var last entity.Chat
+	err := tx.Scopes(notExpired).Where("group_id = ?", groupId).Order("created_at DESC").Order("id DESC").First(&last).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return apperror.FromDB(err, "group not found")
+	}
+	if err == nil {
+		var attachments int64
+		if err := tx.Model(&entity.Attachment{}).Where("chat_id = ?", last.ID).Count(&attachments).Error; err != nil {
+			return apperror.FromDB(err, "chat not found")
+		}
+		preview = lastMessage(last.Message, attachments > 0)
+	}
+
+	err = tx.Model(&entity.ChatGroup{}).Where("id = ?", groupId).Update("last_message", preview).Error
+	return apperror.FromDB(err, "group not found")
 }
 
-func (r *chatRepo) UpdateChat(ctx context.Context, chatId uint, message string) (*entity.Chat, error) {
+// UpdateChat stores the previous message as a revision before replacing it.
+// An edit that does not change the message only refreshes the mentions.
+func (r *chatRepo) UpdateChat(ctx context.Context, chatId, editorId uint, message string, mentionIds []uint) (*entity.Chat, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var chat entity.Chat
+		if err := tx.Select("id", "group_id", "message").Where("id = ?", chatId).First(&chat).Error; err != nil {
+			return apperror.FromDB(err, "chat not found")
+		}
 
-	if err := r.db.Model(&entity.Chat{}).WithContext(ctx).Where("id = ?", chatId).Update("message", message).Error; err != nil {
+		if chat.Message != message {
+			revision := entity.ChatRevision{
+				ChatID:        chatId,
+				Message:       chat.Message,
+				GroupMemberID: &editorId,
+			}
+			if err := tx.Create(&revision).Error; err != nil {
+				return apperror.FromDB(err, "chat not found")
+			}
+
+			if err := tx.Model(&entity.Chat{}).Where("id = ?", chatId).Updates(map[string]interface{}{
+				"message":   message,
+				"edited_at": revision.CreatedAt,
+			}).Error; err != nil {
+				return apperror.FromDB(err, "chat not found")
+			}
+		}
+		if err := replaceMentions(tx, chatId, mentionIds); err != nil {
+			return err
+		}
+		return refreshLastMessage(tx, chat.GroupID)
+	})
+	if err != nil {
 		return nil, err
 	}
 
 	var chat entity.Chat
-	if err := r.db.Preload("GroupMember.User").Preload("ReadStatus").Where("id = ?", chatId).First(&chat).Error; err != nil {
-		return nil, err
+	if err := r.db.Scopes(preloadChat).Where("id = ?", chatId).First(&chat).Error; err != nil {
+		return nil, apperror.FromDB(err, "chat not found")
 	}
 	return &chat, nil
 }
 
 // write group & member
-func (r *chatRepo) CreateGroup(ctx context.Context, name, desc string, userId uint) error {
+func (r *chatRepo) CreateGroup(ctx context.Context, name, desc, visibility string, userId uint) error {
 	tx := r.db.WithContext(ctx).Begin()
 
 	newGroup := entity.ChatGroup{
 		Name:        name,
 		Description: desc,
+		Visibility:  visibility,
 	}
 	if err := tx.Create(&newGroup).Error; err != nil {
 		tx.Rollback()
-		return err
+		return apperror.FromDB(err, "group not found")
 	}
 
 	admin := entity.GroupMember{
@@ -135,47 +408,62 @@ func (r *chatRepo) CreateGroup(ctx context.Context, name, desc string, userId ui
 	}
 	if err := tx.Create(&admin).Error; err != nil {
 		tx.Rollback()
-		return err
+		return apperror.FromDB(err, "user not found")
 	}
 
 	if err := tx.Commit().Error; err != nil {
-		return err
+		return apperror.FromDB(err, "group not found")
 	}
 
 	return nil
 }
 
-func (r *chatRepo) UpdateGroup(ctx context.Context, name, desc string, groupId uint) error {
-	return r.db.Model(&entity.ChatGroup{}).Where("id = ?", groupId).Updates(map[string]interface{}{
+// UpdateGroup keeps the message ttl and visibility when they are nil. A new
+// ttl only applies to chats created afterwards.
+func (r *chatRepo) UpdateGroup(ctx context.Context, name, desc string, groupId uint, messageTTL *int64, visibility *string) error {
+	updates := map[string]interface{}{
 		"name":        name,
 		"description": desc,
-	}).Error
+	}
+	if messageTTL != nil {
+		updates["message_ttl_seconds"] = *messageTTL
+	}
+	if visibility != nil {
+		updates["visibility"] = *visibility
+	}
+	err := r.db.Model(&entity.ChatGroup{}).Where("id = ?", groupId).Updates(updates).Error
+	return apperror.FromDB(err, "group not found")
 }
 
 func (r *chatRepo) DeleteGroup(ctx context.Context, groupId uint) error {
-	return r.db.Model(&entity.ChatGroup{}).WithContext(ctx).Where("id = ?", groupId).Delete(&entity.ChatGroup{}).Error
+	err := r.db.Model(&entity.ChatGroup{}).WithContext(ctx).Where("id = ?", groupId).Delete(&entity.ChatGroup{}).Error
+	return apperror.FromDB(err, "group not found")
 }
 
 func (r *chatRepo) AddMember(req []entity.GroupMember) error {
-	return r.db.Model(&entity.GroupMember{}).CreateInBatches(req, 2).Error
+	err := r.db.Model(&entity.GroupMember{}).CreateInBatches(req, 2).Error
+	return apperror.FromDB(err, "user not found")
 }
 
 func (r *chatRepo) RemoveMember(req []uint) error {
-	return r.db.Model(&entity.GroupMember{}).Where("id IN ?", req).Delete(&entity.GroupMember{}).Error
+	err := r.db.Model(&entity.GroupMember{}).Where("id IN ?", req).Delete(&entity.GroupMember{}).Error
+	return apperror.FromDB(err, "member not found")
 }
 
 func (r *chatRepo) ExitGroup(memberId uint) error {
-	return r.db.Model(&entity.GroupMember{}).Where("id = ?", memberId).Delete(&entity.GroupMember{}).Error
+	err := r.db.Model(&entity.GroupMember{}).Where("id = ?", memberId).Delete(&entity.GroupMember{}).Error
+	return apperror.FromDB(err, "member not found")
 }
 
 func (r *chatRepo) UpdateRoleUser(memberId uint, role string) error {
-	return r.db.Model(&entity.GroupMember{}).Where("id = ?", memberId).Update("role", role).Error
+	err := r.db.Model(&entity.GroupMember{}).Where("id = ?", memberId).Update("role", role).Error
+	return apperror.FromDB(err, "member not found")
 }
 
 func (r *chatRepo) IsMemberAdmin(memberId uint) (bool, error) {
 	var count int64
 	if err := r.db.Model(&entity.GroupMember{}).Where("id = ? AND role = ?", memberId, "admin").Count(&count).Error; err != nil {
-		return false, err
+		return false, apperror.FromDB(err, "member not found")
 	}
 
 	return count > 0, nil
@@ -188,25 +476,52 @@ func (r *chatRepo) GetGroupMemberID(ctx context.Context, userID, groupID uint) (
 
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return false, 0, err
+			return false, 0, nil
 		}
+		return false, 0, apperror.FromDB(err, "member not found")
 	}
 	return true, member.ID, nil
 }
 
+func (r *chatRepo) GetUserMemberships(ctx context.Context, userId uint) ([]entity.GroupMember, error) {
+	var members []entity.GroupMember
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userId).Find(&members).Error; err != nil {
+		return nil, apperror.FromDB(err, "member not found")
+	}
+	return members, nil
+}
+
 func (r *chatRepo) GetChatsByGroupID(groupID uint) ([]entity.Chat, error) {
 	var chats []entity.Chat
-	if err := r.db.Preload("GroupMember.User").Preload("ReadStatus").Where("group_id = ?", groupID).Order("created_at ASC").Find(&chats).Error; err != nil {
-		return nil, err
+	// termasuk chat yang dihapus, dikirim sebagai tombstone. Chat yang expired tidak
+	if err := r.db.Unscoped().Scopes(preloadChat, notExpired).Where("group_id = ?", groupID).Order("created_at ASC").Find(&chats).Error; err != nil {
+		return nil, apperror.FromDB(err, "group not found")
 	}
 
 	return chats, nil
 }
 
+// GetChat also returns deleted chats, check DeletedAt where that matters
+func (r *chatRepo) GetChat(ctx context.Context, chatId uint) (*entity.Chat, error) {
+	var chat entity.Chat
+	if err := r.db.WithContext(ctx).Unscoped().Scopes(preloadChat).Where("id = ?", chatId).First(&chat).Error; err != nil {
+		return nil, apperror.FromDB(err, "chat not found")
+	}
+	return &chat, nil
+}
+
+func (r *chatRepo) GetGroup(ctx context.Context, groupId uint) (*entity.ChatGroup, error) {
+	var group entity.ChatGroup
+	if err := r.db.WithContext(ctx).Where("id = ?", groupId).First(&group).Error; err != nil {
+		return nil, apperror.FromDB(err, "group not found")
+	}
+	return &group, nil
+}
+
 func (r *chatRepo) GetMemberGroup(groupId uint) ([]helper.MemberChat, error) {
 	var members []helper.MemberChat
 	if err := r.db.Model(&entity.GroupMember{}).Select("group_members.id", "users.username").Joins("JOIN users ON users.id = group_members.user_id").Where("group_id =?", groupId).Scan(&members).Error; err != nil {
-		return nil, err
+		return nil, apperror.FromDB(err, "group not found")
 	}
 	return members, nil
 }
@@ -216,7 +531,11 @@ func (r *chatRepo) UpdateUnreadMessage(memberId uint) error {
 		"is_read": true,
 	})
 	if err.Error != nil {
-		return err.Error
+		return apperror.FromDB(err.Error, "member not found")
+	}
+	// mention ikut terbaca saat member membuka chat grup
+	if err := r.db.Model(&entity.Mention{}).Where("group_member_id = ? AND is_read = ?", memberId, false).Update("is_read", true).Error; err != nil {
+		return apperror.FromDB(err, "member not found")
 	}
 	if err.RowsAffected == 0 {
 		return nil
@@ -228,17 +547,20 @@ func (r *chatRepo) GetListGroup(userId uint) ([]helper.GroupInfo, error) {
 
 	var groups []helper.GroupInfo
 	if err := r.db.Model(&entity.GroupMember{}).
-		Select("group_members.id AS group_member_id",
-			"chat_groups.id AS group_id",
-			"chat_groups.name",
-			"chat_groups.last_message",
-			"COUNT(chat_reads.id) AS unread_count").
+		Select("group_members.id AS group_member_id, "+
+			"chat_groups.id AS group_id, "+
+			"chat_groups.name, "+
+			"chat_groups.last_message, "+
+			"chat_groups.message_ttl_seconds, "+
+			"chat_groups.visibility, "+
+			"COUNT(chat_reads.id) AS unread_count, "+
+			"(SELECT COUNT(*) FROM mentions WHERE mentions.group_member_id = group_members.id AND mentions.is_read = ?) AS unread_mention_count", false).
 		Joins("JOIN chat_groups ON chat_groups.id = group_members.group_id").
-		Joins("LEFT JOIN chat_reads ON chat_reads.group_member_id = group_members.id AND chat_reads.is_read = false").
+		Joins("LEFT JOIN chat_reads ON chat_reads.group_member_id = group_members.id AND chat_reads.is_read = ?", false).
 		Where("group_members.user_id = ?", userId).
-		Group("group_members.id, chat_groups.id, chat_groups.name, chat_groups.last_message").
+		Group("group_members.id, chat_groups.id, chat_groups.name, chat_groups.last_message, chat_groups.message_ttl_seconds, chat_groups.visibility").
 		Find(&groups).Error; err != nil {
-		return nil, err
+		return nil, apperror.FromDB(err, "group not found")
 	}
 	return groups, nil
 
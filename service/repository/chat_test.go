@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"chat_api/entity"
+	"chat_api/utils/apperror"
+	"chat_api/utils/helper"
+	"chat_api/utils/testdb"
+	"context"
+	"testing"
+)
+
+func TestCreateChatAttachments(t *testing.T) {
+	db := testdb.Open(t)
+	repo := NewChatRepo(db)
+	ctx := context.Background()
+
+	alice := testdb.User(t, db, "alice")
+	// bob tanpa avatar, avatar_attachment_id NULL tidak boleh membuat NOT IN kosong
+	bob := testdb.User(t, db, "bob")
+	group := testdb.Group(t, db, "g", entity.GroupPrivate)
+	sender := testdb.Member(t, db, group.ID, alice.ID, "admin")
+	testdb.Member(t, db, group.ID, bob.ID, "member")
+
+	attach := func(key, status string) entity.Attachment {
+		a := entity.Attachment{
+			GroupID:       group.ID,
+			GroupMemberID: &sender.ID,
+			FileName:      key + ".jpg",
+			ContentType:   "image/jpeg",
+			Size:          1,
+			Checksum:      "x",
+			StorageKey:    key,
+			Status:        status,
+		}
+		if err := db.Create(&a).Error; err != nil {
+			t.Fatal(err)
+		}
+		return a
+	}
+	plain := attach("plain", entity.AttachmentReady)
+	avatar := attach("avatar", entity.AttachmentReady)
+	processing := attach("processing", entity.AttachmentProcessing)
+	if err := db.Model(&alice).Update("avatar_attachment_id", avatar.ID).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	send := func(ids ...uint) (*entity.Chat, error) {
+		return repo.CreateChat(ctx, &helper.CreateChatReq{
+			MemberId:      sender.ID,
+			GroupId:       group.ID,
+			Message:       "lampiran",
+			AttachmentIDs: ids,
+		})
+	}
+
+	for name, id := range map[string]uint{"avatar": avatar.ID, "processing": processing.ID, "missing": 999} {
+		if _, err := send(id); apperror.KindOf(err) != apperror.KindInvalid {
+			t.Errorf("%s attachment: err = %v, want invalid", name, err)
+		}
+	}
+
+	chat, err := send(plain.ID)
+	if err != nil {
+		t.Fatalf("ready attachment: %v", err)
+	}
+	if len(chat.Attachments) != 1 || chat.Attachments[0].ID != plain.ID {
+		t.Errorf("attachments = %+v, want [%d]", chat.Attachments, plain.ID)
+	}
+
+	// sudah terpakai chat lain
+	if _, err := send(plain.ID); apperror.KindOf(err) != apperror.KindInvalid {
+		t.Errorf("reused attachment: err = %v, want invalid", err)
+	}
+}
@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"chat_api/entity"
+	"chat_api/utils/apperror"
+	"chat_api/utils/helper"
+	"context"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// previewColumns selects helper.GroupPreview, the first argument is the user id
+// used for is_member
+const previewColumns = "chat_groups.id AS group_id, chat_groups.name, chat_groups.description, chat_groups.visibility, " +
+	"(SELECT COUNT(*) FROM group_members WHERE group_members.group_id = chat_groups.id) AS member_count, " +
+	"EXISTS (SELECT 1 FROM group_members WHERE group_members.group_id = chat_groups.id AND group_members.user_id = ?) AS is_member"
+
+// likeEscaper escapes LIKE wildcards with '!', which needs no quoting in any
+// of the supported databases
+var likeEscaper = strings.NewReplacer("!", "!!", "%", "!%", "_", "!_")
+
+// likePattern matches query anywhere, case-insensitive
+func likePattern(query string) string {
+	return "%" + likeEscaper.Replace(strings.ToLower(query)) + "%"
+}
+
+// prefixPattern matches values starting with query, case-insensitive
+func prefixPattern(query string) string {
+	return likeEscaper.Replace(strings.ToLower(query)) + "%"
+}
+
+// SearchPublicGroups matches the query against the name and description of
+// public groups, biggest groups first
+func (r *chatRepo) SearchPublicGroups(ctx context.Context, userId uint, query string, limit, offset int) ([]helper.GroupPreview, int64, error) {
+	db := r.db.WithContext(ctx).Model(&entity.ChatGroup{}).Where("chat_groups.visibility = ?", entity.GroupPublic)
+	if query != "" {
+		pattern := likePattern(query)
+		db = db.Where("LOWER(chat_groups.name) LIKE ? ESCAPE '!' OR LOWER(chat_groups.description) LIKE ? ESCAPE '!'", pattern, pattern)
+	}
+
+	var total int64
+	if err := db.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, apperror.FromDB(err, "group not found")
+	}
+
+	var groups []helper.GroupPreview
+	if err := db.Select(previewColumns, userId).
+		Order("member_count DESC").Order("chat_groups.id").
+		Limit(limit).Offset(offset).
+		Scan(&groups).Error; err != nil {
+		return nil, 0, apperror.FromDB(err, "group not found")
+	}
+	return groups, total, nil
+}
+
+func (r *chatRepo) GetGroupPreview(ctx context.Context, userId, groupId uint) (*helper.GroupPreview, error) {
+	var groups []helper.GroupPreview
+	if err := r.db.WithContext(ctx).Model(&entity.ChatGroup{}).
+		Select(previewColumns, userId).
+		Where("chat_groups.id = ?", groupId).
+		Scan(&groups).Error; err != nil {
+		return nil, apperror.FromDB(err, "group not found")
+	}
+	if len(groups) == 0 {
+		return nil, apperror.NotFound("group not found")
+	}
+	return &groups[0], nil
+}
+
+// JoinPublicGroup adds the user as a member of a public group
+func (r *chatRepo) JoinPublicGroup(ctx context.Context, groupId, userId uint) (*entity.GroupMember, error) {
+	var member entity.GroupMember
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var group entity.ChatGroup
+		if err := tx.Select("id", "visibility").Where("id = ?", groupId).First(&group).Error; err != nil {
+			return apperror.FromDB(err, "group not found")
+		}
+		// sama dengan GetGroupPreview, grup private tidak boleh ketahuan ada
+		if group.Visibility != entity.GroupPublic {
+			return apperror.NotFound("group not found")
+		}
+
+		var count int64
+		if err := tx.Model(&entity.GroupMember{}).Where("user_id = ? AND group_id = ?", userId, groupId).Count(&count).Error; err != nil {
+			return apperror.FromDB(err, "member not found")
+		}
+		if count > 0 {
+			return apperror.AlreadyExists("you are already a member")
+		}
+
+		member = entity.GroupMember{
+			GroupID: groupId,
+			UserID:  userId,
+			Role:    "member",
+		}
+		return apperror.FromDB(tx.Create(&member).Error, "user not found")
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &member, nil
+}
@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"chat_api/entity"
+	"chat_api/utils/apperror"
+	"chat_api/utils/testdb"
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestLikePatterns(t *testing.T) {
+	tests := []struct {
+		query      string
+		wantLike   string
+		wantPrefix string
+	}{
+		{query: "Bob", wantLike: "%bob%", wantPrefix: "bob%"},
+		{query: "50%", wantLike: "%50!%%", wantPrefix: "50!%%"},
+		{query: "a_b", wantLike: "%a!_b%", wantPrefix: "a!_b%"},
+		{query: "hi!", wantLike: "%hi!!%", wantPrefix: "hi!!%"},
+		{query: "!%_", wantLike: "%!!!%!_%", wantPrefix: "!!!%!_%"},
+		{query: "", wantLike: "%%", wantPrefix: "%"},
+	}
+
+	for _, tt := range tests {
+		if got := likePattern(tt.query); got != tt.wantLike {
+			t.Errorf("likePattern(%q) = %q, want %q", tt.query, got, tt.wantLike)
+		}
+		if got := prefixPattern(tt.query); got != tt.wantPrefix {
+			t.Errorf("prefixPattern(%q) = %q, want %q", tt.query, got, tt.wantPrefix)
+		}
+	}
+}
+
+func TestJoinPublicGroup(t *testing.T) {
+	db := testdb.Open(t)
+	repo := NewChatRepo(db)
+	ctx := context.Background()
+
+	public := testdb.Group(t, db, "public", entity.GroupPublic)
+	private := testdb.Group(t, db, "private", entity.GroupPrivate)
+	user := testdb.User(t, db, "alice")
+
+	tests := []struct {
+		name    string
+		groupId uint
+		wantErr bool
+		want    apperror.Kind
+	}{
+		// grup private tidak boleh ketahuan ada
+		{name: "private group", groupId: private.ID, wantErr: true, want: apperror.KindNotFound},
+		{name: "missing group", groupId: 999, wantErr: true, want: apperror.KindNotFound},
+		{name: "public group", groupId: public.ID},
+		{name: "already a member", groupId: public.ID, wantErr: true, want: apperror.KindAlreadyExists},
+	}
+	for _, tt := range tests {
+		member, err := repo.JoinPublicGroup(ctx, tt.groupId, user.ID)
+		if !tt.wantErr {
+			if err != nil {
+				t.Fatalf("%s: unexpected error %v", tt.name, err)
+			}
+			if member.GroupID != tt.groupId || member.UserID != user.ID || member.Role != "member" {
+				t.Errorf("%s: member = %+v", tt.name, member)
+			}
+			continue
+		}
+		if got := apperror.KindOf(err); got != tt.want {
+			t.Errorf("%s: error kind = %v (%v), want %v", tt.name, got, err, tt.want)
+		}
+	}
+
+	var count int64
+	db.Model(&entity.GroupMember{}).Where("group_id = ?", private.ID).Count(&count)
+	if count != 0 {
+		t.Errorf("private group has %d members, want 0", count)
+	}
+}
+
+func TestSearchPublicGroups(t *testing.T) {
+	db := testdb.Open(t)
+	repo := NewChatRepo(db)
+	ctx := context.Background()
+
+	alice := testdb.User(t, db, "alice")
+	bob := testdb.User(t, db, "bob")
+	golang := testdb.Group(t, db, "Golang_ID", entity.GroupPublic)
+	golf := testdb.Group(t, db, "GolangXID", entity.GroupPublic)
+	testdb.Group(t, db, "Golang_Private", entity.GroupPrivate)
+	testdb.Member(t, db, golang.ID, alice.ID, "admin")
+	testdb.Member(t, db, golang.ID, bob.ID, "member")
+
+	tests := []struct {
+		name       string
+		query      string
+		want       []uint
+		wantMember []bool
+	}{
+		// anggota terbanyak dulu, is_member dari EXISTS dibaca sebagai bool
+		{name: "all public groups", query: "", want: []uint{golang.ID, golf.ID}, wantMember: []bool{true, false}},
+		{name: "underscore is literal", query: "golang_", want: []uint{golang.ID}, wantMember: []bool{true}},
+		{name: "no match", query: "rust", want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groups, total, err := repo.SearchPublicGroups(ctx, alice.ID, tt.query, 20, 0)
+			if err != nil {
+				t.Fatalf("SearchPublicGroups: %v", err)
+			}
+			var ids []uint
+			var members []bool
+			for _, g := range groups {
+				ids = append(ids, g.GroupID)
+				members = append(members, g.IsMember)
+			}
+			if !reflect.DeepEqual(ids, tt.want) || !reflect.DeepEqual(members, tt.wantMember) {
+				t.Errorf("groups = %v (member %v), want %v (member %v)", ids, members, tt.want, tt.wantMember)
+			}
+			if total != int64(len(tt.want)) {
+				t.Errorf("total = %d, want %d", total, len(tt.want))
+			}
+		})
+	}
+
+	preview, err := repo.GetGroupPreview(ctx, bob.ID, golang.ID)
+	if err != nil {
+		t.Fatalf("GetGroupPreview: %v", err)
+	}
+	if !preview.IsMember || preview.MemberCount != 2 {
+		t.Errorf("preview = %+v, want member of a 2 member group", preview)
+	}
+}
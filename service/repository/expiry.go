@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"chat_api/entity"
+	"chat_api/utils/apperror"
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// notExpired hides chats whose ttl has passed but that the janitor has not
+// deleted yet
+func notExpired(db *gorm.DB) *gorm.DB {
+	return db.Where("chats.expires_at IS NULL OR chats.expires_at > ?", time.Now().UTC())
+}
+
+// messageExpiry returns when a chat created now in the group expires, nil when
+// the group has no message ttl
+func messageExpiry(tx *gorm.DB, groupId uint) (*time.Time, error) {
+	var group entity.ChatGroup
+	if err := tx.Select("id", "message_ttl_seconds").Where("id = ?", groupId).First(&group).Error; err != nil {
+		return nil, apperror.FromDB(err, "group not found")
+	}
+	if group.MessageTTLSeconds <= 0 {
+		return nil, nil
+	}
+	expiresAt := time.Now().UTC().Add(time.Duration(group.MessageTTLSeconds) * time.Second)
+	return &expiresAt, nil
+}
+
+// GetExpiredChatIDs returns chats (deleted ones included) that expired at or
+// before now, oldest expiry first
+func (r *chatRepo) GetExpiredChatIDs(ctx context.Context, now time.Time, afterId uint, limit int) ([]uint, error) {
+	var ids []uint
+	if err := r.db.WithContext(ctx).Unscoped().Model(&entity.Chat{}).
+		Where("expires_at <= ? AND id > ?", now, afterId).
+		Order("id").Limit(limit).
+		Pluck("id", &ids).Error; err != nil {
+		return nil, apperror.FromDB(err, "chat not found")
+	}
+	return ids, nil
+}
@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"chat_api/entity"
+	"chat_api/utils/apperror"
+	"chat_api/utils/helper"
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ForwardChats creates the forwarded copies (with their attachments) in one
+// transaction. readers holds the members of each destination group that get
+// a read status, keyed by group id.
+func (r *chatRepo) ForwardChats(ctx context.Context, chats []entity.Chat, readers map[uint][]helper.StatusUser) ([]entity.Chat, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		lastChat := make(map[uint]*entity.Chat)
+		expiry := make(map[uint]*time.Time)
+		for i := range chats {
+			chat := &chats[i]
+			expiresAt, ok := expiry[chat.GroupID]
+			if !ok {
+				var err error
+				if expiresAt, err = messageExpiry(tx, chat.GroupID); err != nil {
+					return err
+				}
+				expiry[chat.GroupID] = expiresAt
+			}
+			chat.ExpiresAt = expiresAt
+
+			if err := tx.Create(chat).Error; err != nil {
+				return apperror.FromDB(err, "group not found")
+			}
+
+			var chatRead []entity.ChatRead
+			for _, cr := range readers[chat.GroupID] {
+				if chat.GroupMemberID != nil && cr.MemberId == *chat.GroupMemberID {
+					continue
+				}
+				chatRead = append(chatRead, entity.ChatRead{
+					ChatID:        chat.ID,
+					GroupMemberID: cr.MemberId,
+					IsRead:        cr.Status == "online",
+				})
+			}
+			if len(chatRead) > 0 {
+				if err := tx.CreateInBatches(&chatRead, 10).Error; err != nil {
+					return apperror.FromDB(err, "member not found")
+				}
+			}
+			lastChat[chat.GroupID] = chat
+		}
+
+		for groupId, chat := range lastChat {
+			if err := tx.Model(&entity.ChatGroup{}).
+				Where("id = ?", groupId).
+				Update("last_message", lastMessage(chat.Message, len(chat.Attachments) > 0)).Error; err != nil {
+				return apperror.FromDB(err, "group not found")
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uint, len(chats))
+	for i, c := range chats {
+		ids[i] = c.ID
+	}
+	return r.GetChatsByIDs(ctx, ids)
+}
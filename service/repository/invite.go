@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"chat_api/entity"
+	"chat_api/utils/apperror"
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+func (r *chatRepo) CreateInvite(ctx context.Context, invite *entity.GroupInvite) error {
+	err := r.db.WithContext(ctx).Create(invite).Error
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return apperror.AlreadyExists("invite code already exists")
+	}
+	return apperror.FromDB(err, "group not found")
+}
+
+// ListInvites returns every invite of the group, including expired and used
+// up ones, newest first
+func (r *chatRepo) ListInvites(ctx context.Context, groupId uint) ([]entity.GroupInvite, error) {
+	var invites []entity.GroupInvite
+	if err := r.db.WithContext(ctx).Where("group_id = ?", groupId).Order("created_at DESC").Order("id DESC").Find(&invites).Error; err != nil {
+		return nil, apperror.FromDB(err, "group not found")
+	}
+	return invites, nil
+}
+
+func (r *chatRepo) RevokeInvite(ctx context.Context, groupId uint, code string) error {
+	res := r.db.WithContext(ctx).Where("group_id = ? AND code = ?", groupId, code).Delete(&entity.GroupInvite{})
+	if res.Error != nil {
+		return apperror.FromDB(res.Error, "invite not found")
+	}
+	if res.RowsAffected == 0 {
+		return apperror.NotFound("invite not found")
+	}
+	return nil
+}
+
+// JoinByInvite uses one slot of the invite and adds the user to its group
+// with the invite role, in one transaction.
+func (r *chatRepo) JoinByInvite(ctx context.Context, code string, userId uint) (*entity.GroupMember, error) {
+	var member entity.GroupMember
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var invite entity.GroupInvite
+		if err := tx.Where("code = ?", code).First(&invite).Error; err != nil {
+			return apperror.FromDB(err, "invite not found")
+		}
+		if invite.ExpiresAt != nil && !invite.ExpiresAt.After(time.Now().UTC()) {
+			return apperror.Conflict("invite has expired")
+		}
+
+		var count int64
+		if err := tx.Model(&entity.GroupMember{}).Where("user_id = ? AND group_id = ?", userId, invite.GroupID).Count(&count).Error; err != nil {
+			return apperror.FromDB(err, "member not found")
+		}
+		if count > 0 {
+			return apperror.AlreadyExists("you are already a member")
+		}
+
+		// uses dinaikkan dengan kondisi supaya join bersamaan tidak melewati max_uses
+		res := tx.Model(&entity.GroupInvite{}).
+			Where("id = ? AND (max_uses = 0 OR uses < max_uses)", invite.ID).
+			Update("uses", gorm.Expr("uses + 1"))
+		if res.Error != nil {
+			return apperror.FromDB(res.Error, "invite not found")
+		}
+		if res.RowsAffected == 0 {
+			return apperror.Conflict("invite has reached its maximum uses")
+		}
+
+		member = entity.GroupMember{
+			GroupID: invite.GroupID,
+			UserID:  userId,
+			Role:    invite.Role,
+		}
+		return apperror.FromDB(tx.Create(&member).Error, "user not found")
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &member, nil
+}
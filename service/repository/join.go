@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"chat_api/entity"
+	"chat_api/utils/apperror"
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	joinPending  = "pending"
+	joinApproved = "approved"
+	joinRejected = "rejected"
+)
+
+// CreateJoinRequest rejects users that are already a member or still have
+// a pending request for the group
+func (r *chatRepo) CreateJoinRequest(ctx context.Context, req *entity.JoinRequest) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Model(&entity.GroupMember{}).Where("user_id = ? AND group_id = ?", req.UserID, req.GroupID).Count(&count).Error; err != nil {
+			return apperror.FromDB(err, "member not found")
+		}
+		if count > 0 {
+			return apperror.AlreadyExists("you are already a member")
+		}
+
+		if err := tx.Model(&entity.JoinRequest{}).Where("user_id = ? AND group_id = ? AND status = ?", req.UserID, req.GroupID, joinPending).Count(&count).Error; err != nil {
+			return apperror.FromDB(err, "join request not found")
+		}
+		if count > 0 {
+			return apperror.AlreadyExists("join request already pending")
+		}
+
+		req.Status = joinPending
+		return apperror.FromDB(tx.Create(req).Error, "group not found")
+	})
+	if err != nil {
+		return err
+	}
+	return apperror.FromDB(r.db.WithContext(ctx).Preload("User").First(req, req.ID).Error, "join request not found")
+}
+
+// ListJoinRequests returns the pending requests of a group, oldest first
+func (r *chatRepo) ListJoinRequests(ctx context.Context, groupId uint) ([]entity.JoinRequest, error) {
+	var reqs []entity.JoinRequest
+	if err := r.db.WithContext(ctx).Preload("User").
+		Where("group_id = ? AND status = ?", groupId, joinPending).
+		Order("created_at").Order("id").
+		Find(&reqs).Error; err != nil {
+		return nil, apperror.FromDB(err, "group not found")
+	}
+	return reqs, nil
+}
+
+// ReviewJoinRequest approves or rejects a pending request. On approval the
+// user is added as member; the returned member is nil when the user already
+// joined in another way (e.g. an invite) in the meantime.
+func (r *chatRepo) ReviewJoinRequest(ctx context.Context, groupId, requestId, reviewerId uint, approve bool) (*entity.JoinRequest, *entity.GroupMember, error) {
+	var member *entity.GroupMember
+	status := joinRejected
+	if approve {
+		status = joinApproved
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var req entity.JoinRequest
+		if err := tx.Where("id = ? AND group_id = ?", requestId, groupId).First(&req).Error; err != nil {
+			return apperror.FromDB(err, "join request not found")
+		}
+		if req.Status != joinPending {
+			return apperror.Conflict("join request is already " + req.Status)
+		}
+
+		res := tx.Model(&entity.JoinRequest{}).Where("id = ? AND status = ?", requestId, joinPending).Updates(map[string]interface{}{
+			"status":          status,
+			"group_member_id": reviewerId,
+			"reviewed_at":     time.Now(),
+		})
+		if res.Error != nil {
+			return apperror.FromDB(res.Error, "join request not found")
+		}
+		if res.RowsAffected == 0 {
+			return apperror.Conflict("join request is already reviewed")
+		}
+		if !approve {
+			return nil
+		}
+
+		var count int64
+		if err := tx.Model(&entity.GroupMember{}).Where("user_id = ? AND group_id = ?", req.UserID, groupId).Count(&count).Error; err != nil {
+			return apperror.FromDB(err, "member not found")
+		}
+		if count > 0 {
+			return nil
+		}
+		member = &entity.GroupMember{
+			GroupID: groupId,
+			UserID:  req.UserID,
+			Role:    "member",
+		}
+		return apperror.FromDB(tx.Create(member).Error, "user not found")
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var req entity.JoinRequest
+	if err := r.db.WithContext(ctx).Preload("User").First(&req, requestId).Error; err != nil {
+		return nil, nil, apperror.FromDB(err, "join request not found")
+	}
+	return &req, member, nil
+}
+
+func (r *chatRepo) GetGroupAdminIDs(ctx context.Context, groupId uint) ([]uint, error) {
+	var ids []uint
+	if err := r.db.WithContext(ctx).Model(&entity.GroupMember{}).Where("group_id = ? AND role = ?", groupId, "admin").Pluck("id", &ids).Error; err != nil {
+		return nil, apperror.FromDB(err, "group not found")
+	}
+	return ids, nil
+}
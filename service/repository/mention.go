@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"chat_api/entity"
+	"chat_api/utils/apperror"
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (r *chatRepo) ListMentions(ctx context.Context, userId, groupId uint, unreadOnly bool, limit int) ([]entity.Mention, error) {
+	query := r.db.WithContext(ctx).
+		Preload("Chat", preloadChat).
+		Joins("JOIN group_members ON group_members.id = mentions.group_member_id").
+		Where("group_members.user_id = ?", userId)
+	if groupId != 0 {
+		query = query.Where("group_members.group_id = ?", groupId)
+	}
+	if unreadOnly {
+		query = query.Where("mentions.is_read = ?", false)
+	}
+
+	var mentions []entity.Mention
+	if err := query.Order("mentions.id DESC").Limit(limit).Find(&mentions).Error; err != nil {
+		return nil, apperror.FromDB(err, "mention not found")
+	}
+	return mentions, nil
+}
+
+// replaceMentions makes the stored mentions of a chat match memberIds.
+// Mentions that stay keep their read state.
+func replaceMentions(tx *gorm.DB, chatId uint, memberIds []uint) error {
+	remove := tx.Where("chat_id = ?", chatId)
+	if len(memberIds) > 0 {
+		remove = remove.Where("group_member_id NOT IN ?", memberIds)
+	}
+	if err := remove.Delete(&entity.Mention{}).Error; err != nil {
+		return apperror.FromDB(err, "chat not found")
+	}
+
+	if len(memberIds) == 0 {
+		return nil
+	}
+
+	var mentions []entity.Mention
+	for _, id := range memberIds {
+		mentions = append(mentions, entity.Mention{
+			ChatID:        chatId,
+			GroupMemberID: id,
+		})
+	}
+	err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&mentions).Error
+	return apperror.FromDB(err, "member not found")
+}
@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"chat_api/entity"
+	"chat_api/utils/apperror"
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PinChat pins a chat unless the group already has maxPins pinned chats
+func (r *chatRepo) PinChat(ctx context.Context, pin *entity.Pin, maxPins int) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// kunci baris grup supaya pin yang bersamaan dihitung bergantian
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&entity.ChatGroup{}, pin.GroupID).Error; err != nil {
+			return apperror.FromDB(err, "group not found")
+		}
+
+		var count int64
+		if err := tx.Model(&entity.Pin{}).Where("group_id = ?", pin.GroupID).Count(&count).Error; err != nil {
+			return apperror.FromDB(err, "group not found")
+		}
+		if count >= int64(maxPins) {
+			return apperror.Conflict(fmt.Sprintf("group already has %d pinned messages", maxPins))
+		}
+
+		err := tx.Create(pin).Error
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return apperror.AlreadyExists("chat already pinned")
+		}
+		return apperror.FromDB(err, "chat not found")
+	})
+}
+
+func (r *chatRepo) UnpinChat(ctx context.Context, chatId uint) error {
+	res := r.db.WithContext(ctx).Where("chat_id = ?", chatId).Delete(&entity.Pin{})
+	if res.Error != nil {
+		return apperror.FromDB(res.Error, "pin not found")
+	}
+	if res.RowsAffected == 0 {
+		return apperror.NotFound("chat is not pinned")
+	}
+	return nil
+}
+
+// GetPinnedChats returns the pinned chats of a group, latest pin first
+func (r *chatRepo) GetPinnedChats(ctx context.Context, groupId uint) ([]entity.Chat, error) {
+	var chats []entity.Chat
+	if err := r.db.WithContext(ctx).Scopes(preloadChat, notExpired).
+		Joins("JOIN pins ON pins.chat_id = chats.id").
+		Where("pins.group_id = ?", groupId).
+		Order("pins.created_at DESC").Order("pins.id DESC").
+		Find(&chats).Error; err != nil {
+		return nil, apperror.FromDB(err, "group not found")
+	}
+	return chats, nil
+}
@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"chat_api/entity"
+	"chat_api/utils/apperror"
+	"chat_api/utils/testdb"
+	"context"
+	"testing"
+)
+
+func TestPinChatLimit(t *testing.T) {
+	db := testdb.Open(t)
+	repo := NewChatRepo(db)
+	ctx := context.Background()
+
+	group := testdb.Group(t, db, "g", entity.GroupPrivate)
+	other := testdb.Group(t, db, "other", entity.GroupPrivate)
+	admin := testdb.Member(t, db, group.ID, testdb.User(t, db, "admin").ID, "admin")
+	otherAdmin := testdb.Member(t, db, other.ID, testdb.User(t, db, "other").ID, "admin")
+
+	pin := func(groupId, memberId uint) error {
+		chat := testdb.Chat(t, db, groupId, memberId, "penting")
+		return repo.PinChat(ctx, &entity.Pin{ChatID: chat.ID, GroupID: groupId, GroupMemberID: &memberId}, 2)
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := pin(group.ID, admin.ID); err != nil {
+			t.Fatalf("pin %d: %v", i+1, err)
+		}
+	}
+	if err := pin(group.ID, admin.ID); apperror.KindOf(err) != apperror.KindConflict {
+		t.Errorf("pin over the limit: err = %v, want conflict", err)
+	}
+	// batas dihitung per grup
+	if err := pin(other.ID, otherAdmin.ID); err != nil {
+		t.Errorf("pin in another group: %v", err)
+	}
+
+	var pinned entity.Pin
+	if err := db.Where("group_id = ?", group.ID).First(&pinned).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.UnpinChat(ctx, pinned.ChatID); err != nil {
+		t.Fatalf("unpin: %v", err)
+	}
+	if err := pin(group.ID, admin.ID); err != nil {
+		t.Errorf("pin after unpin: %v", err)
+	}
+
+	if err := repo.PinChat(ctx, &entity.Pin{ChatID: pinned.ChatID + 100, GroupID: 999}, 2); !apperror.IsNotFound(err) {
+		t.Errorf("missing group: err = %v, want not found", err)
+	}
+}
+
+func TestPinChatTwice(t *testing.T) {
+	db := testdb.Open(t)
+	repo := NewChatRepo(db)
+	ctx := context.Background()
+
+	group := testdb.Group(t, db, "g", entity.GroupPrivate)
+	admin := testdb.Member(t, db, group.ID, testdb.User(t, db, "admin").ID, "admin")
+	chat := testdb.Chat(t, db, group.ID, admin.ID, "penting")
+
+	if err := repo.PinChat(ctx, &entity.Pin{ChatID: chat.ID, GroupID: group.ID}, 5); err != nil {
+		t.Fatalf("pin: %v", err)
+	}
+	if err := repo.PinChat(ctx, &entity.Pin{ChatID: chat.ID, GroupID: group.ID}, 5); apperror.KindOf(err) != apperror.KindAlreadyExists {
+		t.Errorf("pin twice: err = %v, want already exists", err)
+	}
+}
@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"chat_api/entity"
+	"chat_api/utils/apperror"
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// AddReaction stores the reaction unless it would push the chat over
+// maxDistinct different emoji. Reacting with an emoji that is already on the
+// chat is always allowed.
+func (r *chatRepo) AddReaction(ctx context.Context, reaction *entity.Reaction, maxDistinct int) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// kunci baris chat supaya reaction baru yang bersamaan dihitung bergantian
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&entity.Chat{}, reaction.ChatID).Error; err != nil {
+			return apperror.FromDB(err, "chat not found")
+		}
+
+		var emojis []string
+		if err := tx.Model(&entity.Reaction{}).Where("chat_id = ?", reaction.ChatID).Distinct().Pluck("emoji", &emojis).Error; err != nil {
+			return apperror.FromDB(err, "chat not found")
+		}
+
+		exists := false
+		for _, e := range emojis {
+			if e == reaction.Emoji {
+				exists = true
+			}
+		}
+		if !exists && len(emojis) >= maxDistinct {
+			return apperror.Conflict(fmt.Sprintf("chat already has %d different reactions", maxDistinct))
+		}
+
+		err := tx.Create(reaction).Error
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return apperror.AlreadyExists("reaction already exists")
+		}
+		return apperror.FromDB(err, "chat not found")
+	})
+}
+
+func (r *chatRepo) RemoveReaction(ctx context.Context, chatId, memberId uint, emoji string) error {
+	res := r.db.WithContext(ctx).Where("chat_id = ? AND group_member_id = ? AND emoji = ?", chatId, memberId, emoji).Delete(&entity.Reaction{})
+	if res.Error != nil {
+		return apperror.FromDB(res.Error, "reaction not found")
+	}
+	if res.RowsAffected == 0 {
+		return apperror.NotFound("reaction not found")
+	}
+	return nil
+}
+
+func (r *chatRepo) GetReactions(ctx context.Context, chatId uint) ([]entity.Reaction, error) {
+	var reactions []entity.Reaction
+	if err := r.db.WithContext(ctx).Where("chat_id = ?", chatId).Order("id").Find(&reactions).Error; err != nil {
+		return nil, apperror.FromDB(err, "chat not found")
+	}
+	return reactions, nil
+}
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"chat_api/entity"
+	"chat_api/utils/apperror"
+	"chat_api/utils/testdb"
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestAddReactionLimit(t *testing.T) {
+	db := testdb.Open(t)
+	repo := NewChatRepo(db)
+	ctx := context.Background()
+
+	group := testdb.Group(t, db, "g", entity.GroupPrivate)
+	alice := testdb.Member(t, db, group.ID, testdb.User(t, db, "alice").ID, "admin")
+	bob := testdb.Member(t, db, group.ID, testdb.User(t, db, "bob").ID, "member")
+	chat := testdb.Chat(t, db, group.ID, alice.ID, "halo")
+
+	react := func(memberId uint, emoji string) error {
+		return repo.AddReaction(ctx, &entity.Reaction{ChatID: chat.ID, GroupMemberID: memberId, Emoji: emoji}, 2)
+	}
+
+	steps := []struct {
+		name     string
+		memberId uint
+		emoji    string
+		want     apperror.Kind
+		wantErr  bool
+	}{
+		{name: "first emoji", memberId: alice.ID, emoji: "👍"},
+		{name: "second emoji", memberId: alice.ID, emoji: "🎉"},
+		{name: "third emoji over the limit", memberId: bob.ID, emoji: "🔥", wantErr: true, want: apperror.KindConflict},
+		{name: "existing emoji still allowed", memberId: bob.ID, emoji: "👍"},
+		{name: "same reaction twice", memberId: bob.ID, emoji: "👍", wantErr: true, want: apperror.KindAlreadyExists},
+	}
+	for _, s := range steps {
+		err := react(s.memberId, s.emoji)
+		if !s.wantErr {
+			if err != nil {
+				t.Fatalf("%s: unexpected error %v", s.name, err)
+			}
+			continue
+		}
+		if got := apperror.KindOf(err); got != s.want {
+			t.Fatalf("%s: error kind = %v (%v), want %v", s.name, got, err, s.want)
+		}
+	}
+
+	if err := repo.AddReaction(ctx, &entity.Reaction{ChatID: 999, GroupMemberID: alice.ID, Emoji: "👍"}, 2); !apperror.IsNotFound(err) {
+		t.Errorf("missing chat: err = %v, want not found", err)
+	}
+}
+
+func TestAddReactionLimitConcurrent(t *testing.T) {
+	db := testdb.Open(t)
+	repo := NewChatRepo(db)
+	ctx := context.Background()
+
+	const maxDistinct = 3
+	group := testdb.Group(t, db, "g", entity.GroupPrivate)
+	sender := testdb.Member(t, db, group.ID, testdb.User(t, db, "sender").ID, "admin")
+	chat := testdb.Chat(t, db, group.ID, sender.ID, "halo")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		member := testdb.Member(t, db, group.ID, testdb.User(t, db, fmt.Sprintf("user%d", i)).ID, "member")
+		wg.Add(1)
+		go func(memberId uint, emoji string) {
+			defer wg.Done()
+			// error diabaikan, yang diuji hanya batasnya tidak terlewati
+			repo.AddReaction(ctx, &entity.Reaction{ChatID: chat.ID, GroupMemberID: memberId, Emoji: emoji}, maxDistinct)
+		}(member.ID, fmt.Sprintf("e%d", i))
+	}
+	wg.Wait()
+
+	var distinct int64
+	if err := db.Model(&entity.Reaction{}).Where("chat_id = ?", chat.ID).Distinct("emoji").Count(&distinct).Error; err != nil {
+		t.Fatal(err)
+	}
+	if distinct == 0 || distinct > maxDistinct {
+		t.Errorf("chat has %d different reactions, want 1..%d", distinct, maxDistinct)
+	}
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"chat_api/entity"
+	"chat_api/utils/apperror"
+	"context"
+)
+
+// GetChatRevisions returns the previous versions of a chat, oldest first
+func (r *chatRepo) GetChatRevisions(ctx context.Context, chatId uint) ([]entity.ChatRevision, error) {
+	var revisions []entity.ChatRevision
+	if err := r.db.WithContext(ctx).Preload("GroupMember.User").Where("chat_id = ?", chatId).Order("id").Find(&revisions).Error; err != nil {
+		return nil, apperror.FromDB(err, "chat not found")
+	}
+	return revisions, nil
+}
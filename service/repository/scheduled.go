@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"chat_api/entity"
+	"chat_api/utils/apperror"
+	"context"
+	"time"
+)
+
+func (r *chatRepo) CreateScheduledMessage(ctx context.Context, msg *entity.ScheduledMessage) error {
+	err := r.db.WithContext(ctx).Create(msg).Error
+	return apperror.FromDB(err, "group not found")
+}
+
+// ListScheduledMessages returns the messages of a user that are still
+// pending or failed, groupId 0 for every group.
+func (r *chatRepo) ListScheduledMessages(ctx context.Context, userId, groupId uint) ([]entity.ScheduledMessage, error) {
+	query := r.db.WithContext(ctx).Where("user_id = ? AND status IN ?", userId, []string{entity.ScheduledPending, entity.ScheduledSending, entity.ScheduledFailed})
+	if groupId != 0 {
+		query = query.Where("group_id = ?", groupId)
+	}
+
+	var msgs []entity.ScheduledMessage
+	if err := query.Order("send_at").Order("id").Find(&msgs).Error; err != nil {
+		return nil, apperror.FromDB(err, "scheduled message not found")
+	}
+	return msgs, nil
+}
+
+// CancelScheduledMessage only cancels a pending message of the user
+func (r *chatRepo) CancelScheduledMessage(ctx context.Context, id, userId uint) error {
+	var msg entity.ScheduledMessage
+	if err := r.db.WithContext(ctx).Where("id = ? AND user_id = ?", id, userId).First(&msg).Error; err != nil {
+		return apperror.FromDB(err, "scheduled message not found")
+	}
+
+	res := r.db.WithContext(ctx).Model(&entity.ScheduledMessage{}).
+		Where("id = ? AND status = ?", id, entity.ScheduledPending).
+		Update("status", entity.ScheduledCanceled)
+	if res.Error != nil {
+		return apperror.FromDB(res.Error, "scheduled message not found")
+	}
+	if res.RowsAffected == 0 {
+		return apperror.Conflict("scheduled message is already " + msg.Status)
+	}
+	return nil
+}
+
+func (r *chatRepo) GetDueScheduledMessages(ctx context.Context, now time.Time, limit int) ([]entity.ScheduledMessage, error) {
+	var msgs []entity.ScheduledMessage
+	if err := r.db.WithContext(ctx).
+		Where("status = ? AND send_at <= ?", entity.ScheduledPending, now).
+		Order("send_at").Order("id").Limit(limit).
+		Find(&msgs).Error; err != nil {
+		return nil, apperror.FromDB(err, "scheduled message not found")
+	}
+	return msgs, nil
+}
+
+// ClaimScheduledMessage moves a message from pending to sending. It returns
+// false when another worker (or a cancel) got there first.
+func (r *chatRepo) ClaimScheduledMessage(ctx context.Context, id uint) (bool, error) {
+	res := r.db.WithContext(ctx).Model(&entity.ScheduledMessage{}).
+		Where("id = ? AND status = ?", id, entity.ScheduledPending).
+		Update("status", entity.ScheduledSending)
+	if res.Error != nil {
+		return false, apperror.FromDB(res.Error, "scheduled message not found")
+	}
+	return res.RowsAffected == 1, nil
+}
+
+func (r *chatRepo) FinishScheduledMessage(ctx context.Context, id uint, status string, chatId *uint, errMsg string) error {
+	err := r.db.WithContext(ctx).Model(&entity.ScheduledMessage{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"status":  status,
+		"chat_id": chatId,
+		"error":   errMsg,
+	}).Error
+	return apperror.FromDB(err, "scheduled message not found")
+}
+
+// RequeueSendingScheduledMessages puts messages that were being sent when the
+// server stopped back to pending, so they are sent at least once.
+func (r *chatRepo) RequeueSendingScheduledMessages(ctx context.Context) error {
+	err := r.db.WithContext(ctx).Model(&entity.ScheduledMessage{}).
+		Where("status = ?", entity.ScheduledSending).
+		Update("status", entity.ScheduledPending).Error
+	return apperror.FromDB(err, "scheduled message not found")
+}
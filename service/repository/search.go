@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"chat_api/entity"
+	"chat_api/utils/apperror"
+	"context"
+)
+
+// GetChatsForIndex pages through all chats by id for (re)building the search index
+func (r *chatRepo) GetChatsForIndex(ctx context.Context, afterId uint, limit int) ([]entity.Chat, error) {
+	var chats []entity.Chat
+	if err := r.db.WithContext(ctx).Preload("GroupMember").Preload("Attachments").Scopes(notExpired).
+		Where("id > ?", afterId).Order("id").Limit(limit).Find(&chats).Error; err != nil {
+		return nil, apperror.FromDB(err, "chat not found")
+	}
+	return chats, nil
+}
+
+// GetChatsByIDs keeps the order of ids; chats that no longer exist or expired
+// are skipped
+func (r *chatRepo) GetChatsByIDs(ctx context.Context, ids []uint) ([]entity.Chat, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	var chats []entity.Chat
+	if err := r.db.WithContext(ctx).Scopes(preloadChat, notExpired).Where("id IN ?", ids).Find(&chats).Error; err != nil {
+		return nil, apperror.FromDB(err, "chat not found")
+	}
+
+	byId := make(map[uint]entity.Chat, len(chats))
+	for _, c := range chats {
+		byId[c.ID] = c
+	}
+	ordered := make([]entity.Chat, 0, len(chats))
+	for _, id := range ids {
+		if c, ok := byId[id]; ok {
+			ordered = append(ordered, c)
+		}
+	}
+	return ordered, nil
+}
+
+func (r *chatRepo) GetUserGroupIDs(ctx context.Context, userId uint) ([]uint, error) {
+	var ids []uint
+	if err := r.db.WithContext(ctx).Model(&entity.GroupMember{}).Where("user_id = ?", userId).Pluck("group_id", &ids).Error; err != nil {
+		return nil, apperror.FromDB(err, "group not found")
+	}
+	return ids, nil
+}
@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"chat_api/entity"
+	"chat_api/utils/apperror"
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+func (r *chatRepo) GetThreadReplies(ctx context.Context, rootId uint) ([]entity.Chat, error) {
+	var replies []entity.Chat
+	if err := r.db.WithContext(ctx).Unscoped().Scopes(preloadChat, notExpired).Where("thread_root_id = ?", rootId).Order("created_at ASC").Find(&replies).Error; err != nil {
+		return nil, apperror.FromDB(err, "chat not found")
+	}
+	return replies, nil
+}
+
+// refreshThreadStats recounts the replies of a thread root, not counting
+// deleted replies. Counting is done in a separate query because MySQL rejects
+// a subquery on the updated table.
+func refreshThreadStats(tx *gorm.DB, rootId uint) error {
+	var count int64
+	if err := tx.Model(&entity.Chat{}).Where("thread_root_id = ?", rootId).Count(&count).Error; err != nil {
+		return apperror.FromDB(err, "chat not found")
+	}
+
+	var lastReplyAt *time.Time
+	if count > 0 {
+		var last entity.Chat
+		if err := tx.Select("created_at").Where("thread_root_id = ?", rootId).Order("created_at DESC").First(&last).Error; err != nil {
+			return apperror.FromDB(err, "chat not found")
+		}
+		lastReplyAt = &last.CreatedAt
+	}
+
+	// root yang sudah dihapus tetap diperbarui karena masih tampil sebagai tombstone
+	err := tx.Unscoped().Model(&entity.Chat{}).Where("id = ?", rootId).Updates(map[string]interface{}{
+		"thread_reply_count": count,
+		"last_reply_at":      lastReplyAt,
+	}).Error
+	return apperror.FromDB(err, "chat not found")
+}
@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"chat_api/entity"
+	"chat_api/utils/apperror"
+	"chat_api/utils/helper"
+	"chat_api/utils/media"
+	"context"
+	"errors"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type UserRepository interface {
+	GetUser(ctx context.Context, userId uint) (*entity.User, error)
+	GetUsers(ctx context.Context, userIds []uint) ([]entity.User, error)
+	UpdateProfile(ctx context.Context, userId uint, req *helper.UpdateProfileReq) (*entity.User, error)
+	SearchUsers(ctx context.Context, callerId uint, query string, limit, offset int) ([]entity.User, int64, error)
+
+	//block
+	BlockUser(ctx context.Context, userId, blockedUserId uint) (*entity.UserBlock, error)
+	UnblockUser(ctx context.Context, userId, blockedUserId uint) error
+	ListBlockedUsers(ctx context.Context, userId uint) ([]entity.UserBlock, error)
+}
+
+type userRepository struct {
+	db *gorm.DB
+}
+
+func NewUserRepo(db *gorm.DB) UserRepository {
+	return &userRepository{db}
+}
+
+func (r *userRepository) GetUser(ctx context.Context, userId uint) (*entity.User, error) {
+	var user entity.User
+	if err := r.db.WithContext(ctx).Where("id = ?", userId).First(&user).Error; err != nil {
+		return nil, apperror.FromDB(err, "user not found")
+	}
+	return &user, nil
+}
+
+// GetUsers keeps the order of userIds; users that do not exist are skipped
+func (r *userRepository) GetUsers(ctx context.Context, userIds []uint) ([]entity.User, error) {
+	var users []entity.User
+	if err := r.db.WithContext(ctx).Where("id IN ?", userIds).Find(&users).Error; err != nil {
+		return nil, apperror.FromDB(err, "user not found")
+	}
+
+	byId := make(map[uint]entity.User, len(users))
+	for _, u := range users {
+		byId[u.ID] = u
+	}
+	ordered := make([]entity.User, 0, len(users))
+	seen := make(map[uint]bool, len(userIds))
+	for _, id := range userIds {
+		if u, ok := byId[id]; ok && !seen[id] {
+			seen[id] = true
+			ordered = append(ordered, u)
+		}
+	}
+	return ordered, nil
+}
+
+// UpdateProfile only changes the fields that are set. A new avatar must be an
+// image uploaded by the user that is not part of a chat.
+func (r *userRepository) UpdateProfile(ctx context.Context, userId uint, req *helper.UpdateProfileReq) (*entity.User, error) {
+	updates := map[string]interface{}{}
+	if req.Username != nil {
+		updates["username"] = *req.Username
+	}
+	if req.DisplayName != nil {
+		updates["display_name"] = *req.DisplayName
+	}
+	if req.Bio != nil {
+		updates["bio"] = *req.Bio
+	}
+	if req.Discoverable != nil {
+		updates["discoverable"] = *req.Discoverable
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if req.AvatarAttachmentID != nil {
+			if *req.AvatarAttachmentID == 0 {
+				updates["avatar_attachment_id"] = nil
+			} else {
+				var attachment entity.Attachment
+				err := tx.Joins("JOIN group_members ON group_members.id = attachments.group_member_id").
+					Where("attachments.id = ? AND group_members.user_id = ? AND attachments.chat_id IS NULL", *req.AvatarAttachmentID, userId).
+					Where("attachments.status = ?", entity.AttachmentReady).
+					First(&attachment).Error
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return apperror.Invalid("avatar attachment not found", apperror.FieldViolation{
+						Field:       "avatar_attachment_id",
+						Description: "attachment must be uploaded by you, finished processing and not sent in a chat",
+					})
+				}
+				if err != nil {
+					return apperror.FromDB(err, "attachment not found")
+				}
+				if !media.IsImage(attachment.ContentType) {
+					return apperror.Invalid("avatar must be an image", apperror.FieldViolation{
+						Field:       "avatar_attachment_id",
+						Description: "content type must be a supported image",
+					})
+				}
+				updates["avatar_attachment_id"] = attachment.ID
+			}
+		}
+		if len(updates) == 0 {
+			return nil
+		}
+
+		err := tx.Model(&entity.User{}).Where("id = ?", userId).Updates(updates).Error
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return apperror.AlreadyExists("username already taken")
+		}
+		return apperror.FromDB(err, "user not found")
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.GetUser(ctx, userId)
+}
+
+// SearchUsers matches an exact email when the query contains "@", otherwise
+// a part of the username (exact and prefix matches first). The caller, users
+// that are not discoverable and users that blocked the caller are left out.
+func (r *userRepository) SearchUsers(ctx context.Context, callerId uint, query string, limit, offset int) ([]entity.User, int64, error) {
+	db := r.db.WithContext(ctx).Model(&entity.User{}).
+		Where("users.id <> ? AND users.discoverable = ?", callerId, true).
+		Where("NOT EXISTS (SELECT 1 FROM user_blocks WHERE user_blocks.user_id = users.id AND user_blocks.blocked_user_id = ?)", callerId)
+
+	lower := strings.ToLower(query)
+	byEmail := strings.Contains(query, "@")
+	if byEmail {
+		db = db.Where("LOWER(users.email) = ?", lower)
+	} else {
+		db = db.Where("LOWER(users.username) LIKE ? ESCAPE '!'", likePattern(query))
+	}
+
+	var total int64
+	if err := db.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, apperror.FromDB(err, "user not found")
+	}
+
+	// gorm Order mengabaikan clause.Expr biasa, jadi seluruh ORDER BY dibungkus clause.OrderBy
+	order := clause.Expr{SQL: "users.username, users.id"}
+	if !byEmail {
+		order = clause.Expr{
+			SQL:  "CASE WHEN LOWER(users.username) = ? THEN 0 WHEN LOWER(users.username) LIKE ? ESCAPE '!' THEN 1 ELSE 2 END, users.username, users.id",
+			Vars: []interface{}{lower, prefixPattern(query)},
+		}
+	}
+
+	var users []entity.User
+	if err := db.Order(clause.OrderBy{Expression: order}).Limit(limit).Offset(offset).Find(&users).Error; err != nil {
+		return nil, 0, apperror.FromDB(err, "user not found")
+	}
+	return users, total, nil
+}
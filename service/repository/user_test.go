@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"chat_api/entity"
+	"chat_api/utils/testdb"
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestSearchUsers(t *testing.T) {
+	db := testdb.Open(t)
+	repo := NewUserRepo(db)
+	ctx := context.Background()
+
+	caller := testdb.User(t, db, "caller")
+	bob := testdb.User(t, db, "bob")
+	bobby := testdb.User(t, db, "Bobby")
+	abob := testdb.User(t, db, "a_bob")
+	percent := testdb.User(t, db, "100%bob")
+	axbob := testdb.User(t, db, "axbob")
+	hidden := testdb.User(t, db, "bob_hidden")
+	blocker := testdb.User(t, db, "bob_blocker")
+
+	if err := db.Model(&hidden).Update("discoverable", false).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Create(&entity.UserBlock{UserID: blocker.ID, BlockedUserID: caller.ID}).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		want  []uint
+	}{
+		// exact dulu, lalu prefix, lalu sisanya; urutan dalam kelompok by username
+		{name: "exact then prefix then substring", query: "BOB", want: []uint{bob.ID, bobby.ID, percent.ID, abob.ID, axbob.ID}},
+		{name: "underscore is literal", query: "a_b", want: []uint{abob.ID}},
+		{name: "percent is literal", query: "0%b", want: []uint{percent.ID}},
+		{name: "escape char is literal", query: "!", want: nil},
+		{name: "email matches exactly", query: "Bobby@Example.com", want: []uint{bobby.ID}},
+		{name: "partial email does not match", query: "bob@example", want: nil},
+		{name: "caller is excluded", query: "caller", want: nil},
+		{name: "hidden user by email", query: "bob_hidden@example.com", want: nil},
+		{name: "blocker by email", query: "bob_blocker@example.com", want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users, total, err := repo.SearchUsers(ctx, caller.ID, tt.query, 20, 0)
+			if err != nil {
+				t.Fatalf("SearchUsers: %v", err)
+			}
+			var got []uint
+			for _, u := range users {
+				got = append(got, u.ID)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ids = %v, want %v", got, tt.want)
+			}
+			if total != int64(len(tt.want)) {
+				t.Errorf("total = %d, want %d", total, len(tt.want))
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package usecase
+
+import (
+	"chat_api/entity"
+	"chat_api/utils/apperror"
+	"chat_api/utils/helper"
+	"chat_api/utils/media"
+	"chat_api/utils/storage"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"time"
+)
+
+const defaultMaxAttachmentSize = 10 << 20
+
+func envInt64(key string, def int64) int64 {
+	v, err := strconv.ParseInt(os.Getenv(key), 10, 64)
+	if err != nil || v <= 0 {
+		return def
+	}
+	return v
+}
+
+// UploadAttachment streams content into storage while hashing it. The blob is
+// removed again when the received size or checksum does not match the info
+// the client declared up front.
+func (u *chatUsecase) UploadAttachment(ctx context.Context, info *helper.AttachmentInfo, content io.Reader) (*entity.Attachment, error) {
+	if info.Size > u.maxAttachmentSize {
+		return nil, apperror.Invalid("attachment too large", apperror.FieldViolation{
+			Field:       "info.size",
+			Description: fmt.Sprintf("value must be at most %d bytes", u.maxAttachmentSize),
+		})
+	}
+
+	key, err := newStorageKey()
+	if err != nil {
+		return nil, apperror.Wrap(apperror.KindInternal, "internal server error", err)
+	}
+
+	// +1 supaya file yang lebih besar dari size yang dideklarasikan tetap terdeteksi
+	hash := sha256.New()
+	n, err := u.storage.Put(ctx, key, io.TeeReader(io.LimitReader(content, info.Size+1), hash))
+	if err != nil {
+		return nil, err
+	}
+
+	if n != info.Size {
+		u.deleteBlob(key)
+		return nil, apperror.Invalid("attachment size does not match", apperror.FieldViolation{
+			Field:       "info.size",
+			Description: fmt.Sprintf("declared %d bytes, received %d", info.Size, n),
+		})
+	}
+	if sum := hex.EncodeToString(hash.Sum(nil)); sum != info.Checksum {
+		u.deleteBlob(key)
+		return nil, apperror.Invalid("attachment checksum does not match", apperror.FieldViolation{
+			Field:       "info.sha256",
+			Description: "sha256 of received content is " + sum,
+		})
+	}
+
+	attachment := &entity.Attachment{
+		GroupID:       info.GroupId,
+		GroupMemberID: &info.MemberId,
+		FileName:      info.FileName,
+		ContentType:   info.ContentType,
+		Size:          n,
+		Checksum:      info.Checksum,
+		StorageKey:    key,
+		Status:        entity.AttachmentReady,
+	}
+	if media.IsImage(info.ContentType) {
+		attachment.Status = entity.AttachmentProcessing
+	}
+	if err := u.chatRepo.CreateAttachment(ctx, attachment); err != nil {
+		u.deleteBlob(key)
+		return nil, err
+	}
+
+	if attachment.Status == entity.AttachmentProcessing {
+		u.enqueueAttachment(attachment.ID)
+	}
+	return attachment, nil
+}
+
+func (u *chatUsecase) GetAttachment(ctx context.Context, attachmentId uint) (*entity.Attachment, error) {
+	attachment, err := u.chatRepo.GetAttachment(ctx, attachmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	// original gambar baru boleh diunduh setelah lokasi/EXIF dibuang
+	switch attachment.Status {
+	case entity.AttachmentReady:
+	case entity.AttachmentProcessing:
+		return nil, apperror.Conflict("attachment is still processing")
+	default:
+		return nil, apperror.NotFound("attachment not found")
+	}
+
+	// attachment dari chat yang dihapus tidak bisa diunduh lagi
+	if attachment.ChatID != nil {
+		chat, err := u.chatRepo.GetChat(ctx, *attachment.ChatID)
+		if err != nil {
+			return nil, err
+		}
+		if chat.DeletedAt.Valid {
+			return nil, apperror.NotFound("attachment not found")
+		}
+	}
+	return attachment, nil
+}
+
+// OpenAttachment opens the original, or the thumbnail with the given size
+// when thumbnailSize is not 0.
+func (u *chatUsecase) OpenAttachment(ctx context.Context, attachment *entity.Attachment, thumbnailSize int) (io.ReadCloser, error) {
+	key := attachment.StorageKey
+	if thumbnailSize != 0 {
+		key = ""
+		for _, t := range attachment.Thumbnails {
+			if t.Size == thumbnailSize {
+				key = t.StorageKey
+			}
+		}
+		if key == "" {
+			return nil, apperror.NotFound("thumbnail not found")
+		}
+	}
+
+	r, err := u.storage.Open(ctx, key)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, apperror.Wrap(apperror.KindNotFound, "attachment not found", err)
+	}
+	if err != nil {
+		return nil, apperror.Wrap(apperror.KindInternal, "internal server error", err)
+	}
+	return r, nil
+}
+
+// deleteBlob pakai context baru, upload yang gagal biasanya context-nya sudah cancel
+func (u *chatUsecase) deleteBlob(key string) {
+	if err := u.storage.Delete(context.Background(), key); err != nil {
+		log.Printf("failed to delete attachment blob %s: %v", key, err)
+	}
+}
+
+// newStorageKey returns an unguessable key grouped by month, e.g.
+// "2025/06/3f2a...". The client file name is never part of the key.
+func newStorageKey() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return time.Now().Format("2006/01") + "/" + hex.EncodeToString(b), nil
+}
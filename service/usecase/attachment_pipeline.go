@@ -0,0 +1,212 @@
+package usecase
+
+import (
+	"bytes"
+	"chat_api/entity"
+	"chat_api/pb"
+	"chat_api/utils/helper"
+	"chat_api/utils/media"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	processTimeout = 2 * time.Minute
+	// untuk mencatat status failed, ctx proses bisa sudah timeout
+	failTimeout = 10 * time.Second
+)
+
+var defaultThumbnailSizes = []int{160, 320, 640}
+
+// thumbnailSizes parses THUMBNAIL_SIZES, e.g. "160,320,640" (longest edge in px)
+func thumbnailSizes() []int {
+	raw := os.Getenv("THUMBNAIL_SIZES")
+	if raw == "" {
+		return defaultThumbnailSizes
+	}
+
+	var sizes []int
+	for _, s := range strings.Split(raw, ",") {
+		size, err := strconv.Atoi(strings.TrimSpace(s))
+		if err != nil || size <= 0 {
+			log.Printf("ignoring invalid thumbnail size %q", s)
+			continue
+		}
+		sizes = append(sizes, size)
+	}
+	return sizes
+}
+
+// startAttachmentPipeline runs the image workers and requeues images left in
+// processing by a previous run.
+func (u *chatUsecase) startAttachmentPipeline(workers int) {
+	for range workers {
+		go u.attachmentWorker()
+	}
+
+	go func() {
+		ids, err := u.chatRepo.GetProcessingAttachmentIDs(context.Background())
+		if err != nil {
+			log.Printf("failed to load pending attachments: %v", err)
+			return
+		}
+		for _, id := range ids {
+			u.enqueueAttachment(id)
+		}
+	}()
+}
+
+// enqueueAttachment never blocks the upload, kalau antrian penuh dikirim dari goroutine
+func (u *chatUsecase) enqueueAttachment(id uint) {
+	select {
+	case u.attachmentJobs <- id:
+	default:
+		go func() { u.attachmentJobs <- id }()
+	}
+}
+
+func (u *chatUsecase) attachmentWorker() {
+	for id := range u.attachmentJobs {
+		ctx, cancel := context.WithTimeout(context.Background(), processTimeout)
+		attachment, err := u.processAttachment(ctx, id)
+		cancel()
+		if err != nil {
+			log.Printf("failed to process attachment %d: %v", id, err)
+			u.failAttachment(id)
+			continue
+		}
+		u.attachmentBroadcast(attachment, pb.Action_AttachmentReady)
+	}
+}
+
+// failAttachment marks the attachment failed and deletes its original, which
+// still contains the metadata the pipeline could not strip
+func (u *chatUsecase) failAttachment(id uint) {
+	ctx, cancel := context.WithTimeout(context.Background(), failTimeout)
+	defer cancel()
+
+	if err := u.chatRepo.UpdateAttachmentStatus(ctx, id, entity.AttachmentFailed); err != nil {
+		log.Printf("failed to mark attachment %d as failed: %v", id, err)
+		return
+	}
+	attachment, lookupErr := u.chatRepo.GetAttachment(ctx, id)
+	if lookupErr != nil {
+		log.Printf("failed to load failed attachment %d: %v", id, lookupErr)
+		return
+	}
+	u.deleteBlob(attachment.StorageKey)
+	u.attachmentBroadcast(attachment, pb.Action_AttachmentFailed)
+}
+
+// processAttachment strips location data from the original, then records
+// dimensions, blurhash and one JPEG thumbnail per configured size.
+func (u *chatUsecase) processAttachment(ctx context.Context, id uint) (*entity.Attachment, error) {
+	attachment, err := u.chatRepo.GetAttachment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := u.storage.Open(ctx, attachment.StorageKey)
+	if err != nil {
+		return nil, err
+	}
+	data, err := io.ReadAll(r)
+	r.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	if media.StripLocation(data) {
+		if _, err := u.storage.Put(ctx, attachment.StorageKey, bytes.NewReader(data)); err != nil {
+			return nil, err
+		}
+		sum := sha256.Sum256(data)
+		attachment.Checksum = hex.EncodeToString(sum[:])
+		attachment.Size = int64(len(data))
+	}
+
+	img, err := media.Decode(data)
+	if err != nil {
+		return nil, err
+	}
+	attachment.Width = img.Bounds().Dx()
+	attachment.Height = img.Bounds().Dy()
+
+	if attachment.Blurhash, err = media.Blurhash(img); err != nil {
+		return nil, err
+	}
+
+	// thumbnail lama dari proses yang terputus diganti
+	existing := make(map[int]bool)
+	for _, t := range attachment.Thumbnails {
+		existing[t.Size] = true
+	}
+
+	var thumbnails []entity.AttachmentThumbnail
+	for _, size := range u.thumbnailSizes {
+		if existing[size] {
+			continue
+		}
+		thumb, ok := media.Thumbnail(img, size)
+		if !ok {
+			continue
+		}
+
+		var buf bytes.Buffer
+		if err := media.EncodeJPEG(&buf, thumb); err != nil {
+			return nil, err
+		}
+		key := fmt.Sprintf("%s_%d.jpg", attachment.StorageKey, size)
+		if _, err := u.storage.Put(ctx, key, &buf); err != nil {
+			return nil, err
+		}
+
+		thumbnails = append(thumbnails, entity.AttachmentThumbnail{
+			AttachmentID: attachment.ID,
+			Size:         size,
+			Width:        thumb.Bounds().Dx(),
+			Height:       thumb.Bounds().Dy(),
+			StorageKey:   key,
+		})
+	}
+
+	attachment.Status = entity.AttachmentReady
+	existingThumbnails := attachment.Thumbnails
+	if err := u.chatRepo.UpdateAttachmentMedia(ctx, attachment, thumbnails); err != nil {
+		return nil, err
+	}
+	attachment.Thumbnails = append(existingThumbnails, attachment.Thumbnails...)
+
+	return attachment, nil
+}
+
+// attachmentBroadcast tells the group that an attachment finished (or failed)
+// processing, whether or not it is already part of a chat.
+func (u *chatUsecase) attachmentBroadcast(attachment *entity.Attachment, action pb.Action) {
+	res := &pb.ChatStreamingResponse{
+		GroupId:     uint64(attachment.GroupID),
+		Timestamp:   time.Now().Format(time.RFC3339),
+		Action:      action,
+		Attachments: []*pb.Attachment{helper.ConvertAttachmentToPb(attachment)},
+	}
+	if attachment.ChatID != nil {
+		res.ChatId = uint64(*attachment.ChatID)
+		// supaya subscriber thread juga menerima event ini
+		if chat, err := u.chatRepo.GetChat(context.Background(), *attachment.ChatID); err == nil && chat.ThreadRootID != nil {
+			res.ThreadRootId = uint64(*chat.ThreadRootID)
+		}
+	}
+	if attachment.GroupMemberID != nil {
+		res.Member = uint64(*attachment.GroupMemberID)
+	}
+
+	u.groupBroadcast(attachment.GroupID, res)
+}
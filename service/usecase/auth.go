@@ -3,10 +3,9 @@ package usecase
 import (
 	"chat_api/entity"
 	"chat_api/service/repository"
+	"chat_api/utils/apperror"
 	"chat_api/utils/helper"
 	"context"
-	"errors"
-	"fmt"
 )
 
 type AuthUsecase interface {
@@ -25,12 +24,15 @@ func NewAuthUsecase(authRepo repository.AuthRepository) AuthUsecase {
 func (u *authUsecase) Login(ctx context.Context, user *entity.User) (string, error) {
 	pw, id, err := u.authRepo.Login(ctx, user)
 	if err != nil {
-		return "", nil
+		// email tidak terdaftar dibalas sama seperti password salah
+		if apperror.IsNotFound(err) {
+			return "", apperror.Unauthenticated("password dan email tidak cocok")
+		}
+		return "", err
 	}
-	fmt.Println(pw)
 	valid := helper.ComparePassword(pw, user.Password)
 	if !valid {
-		return "", errors.New("password dan email tidak cocok")
+		return "", apperror.Unauthenticated("password dan email tidak cocok")
 	}
 
 	token, err := helper.GenerateJWTLogin(id, user.Email)
@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"chat_api/entity"
+	"chat_api/pb"
+	"chat_api/utils/apperror"
+	"context"
+	"log"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func (u *userUsecase) BlockUser(ctx context.Context, userId, blockedUserId uint) (*entity.UserBlock, error) {
+	if userId == blockedUserId {
+		return nil, apperror.Invalid("cannot block yourself", apperror.FieldViolation{
+			Field:       "user_id",
+			Description: "value must not be your own user id",
+		})
+	}
+	return u.userRepo.BlockUser(ctx, userId, blockedUserId)
+}
+
+func (u *userUsecase) UnblockUser(ctx context.Context, userId, blockedUserId uint) error {
+	return u.userRepo.UnblockUser(ctx, userId, blockedUserId)
+}
+
+func (u *userUsecase) ListBlockedUsers(ctx context.Context, userId uint) ([]entity.UserBlock, error) {
+	return u.userRepo.ListBlockedUsers(ctx, userId)
+}
+
+// markBlockedSenders sets SenderBlocked on the chats userId should see
+// flagged, looking in groupId or in every group when it is 0
+func (u *chatUsecase) markBlockedSenders(ctx context.Context, userId, groupId uint, chats ...*entity.Chat) error {
+	ids, err := u.chatRepo.GetBlockedMemberIDs(ctx, userId, groupId)
+	if err != nil || len(ids) == 0 {
+		return err
+	}
+	blocked := make(map[uint]bool, len(ids))
+	for _, id := range ids {
+		blocked[id] = true
+	}
+	for _, chat := range chats {
+		if chat != nil && chat.GroupMemberID != nil {
+			chat.SenderBlocked = blocked[*chat.GroupMemberID]
+		}
+	}
+	return nil
+}
+
+func chatPointers(chats []entity.Chat) []*entity.Chat {
+	result := make([]*entity.Chat, len(chats))
+	for i := range chats {
+		result[i] = &chats[i]
+	}
+	return result
+}
+
+// checkNotBlocked rejects adding users that blocked the admin behind memberId
+func (u *chatUsecase) checkNotBlocked(ctx context.Context, memberId uint, userIds []uint) error {
+	if len(userIds) == 0 {
+		return nil
+	}
+	blockers, err := u.chatRepo.GetBlockersAmong(ctx, memberId, userIds)
+	if err != nil {
+		return err
+	}
+	if len(blockers) > 0 {
+		return apperror.PermissionDenied("cannot add a user that blocked you")
+	}
+	return nil
+}
+
+// chatBroadcastFlagged sends a chat to the group; streams of users that
+// blocked the sender get it with SenderBlocked set
+func (u *chatUsecase) chatBroadcastFlagged(chat *entity.Chat, res *pb.ChatStreamingResponse) {
+	if chat.GroupMemberID == nil {
+		u.groupBroadcast(chat.GroupID, res)
+		return
+	}
+
+	blockers, err := u.chatRepo.GetBlockerUserIDs(context.Background(), *chat.GroupMemberID)
+	if err != nil {
+		log.Printf("failed to load blockers of member %d: %v", *chat.GroupMemberID, err)
+	}
+	if len(blockers) == 0 {
+		u.groupBroadcast(chat.GroupID, res)
+		return
+	}
+
+	flagged := proto.Clone(res).(*pb.ChatStreamingResponse)
+	flagged.SenderBlocked = true
+	byUser := make(map[uint]bool, len(blockers))
+	for _, id := range blockers {
+		byUser[id] = true
+	}
+	u.groupSend(chat.GroupID, func(client *StreamChat) *pb.ChatStreamingResponse {
+		if byUser[client.UserId] {
+			return flagged
+		}
+		return res
+	})
+}
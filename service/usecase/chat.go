@@ -4,9 +4,13 @@ import (
 	"chat_api/entity"
 	"chat_api/pb"
 	"chat_api/service/repository"
+	"chat_api/utils/apperror"
 	"chat_api/utils/helper"
+	"chat_api/utils/search"
+	"chat_api/utils/storage"
 	"context"
 	"fmt"
+	"io"
 	"log"
 	"sync"
 	"time"
@@ -15,32 +19,92 @@ import (
 type ChatUsecase interface {
 	//write chat
 	CreateChat(ctx context.Context, req *helper.CreateChatReq) (*entity.Chat, error)
-	DeleteChat(ctx context.Context, chatId uint) (*entity.Chat, error)
-	UpdateChat(ctx context.Context, chatId uint, message string) (*entity.Chat, error)
+	DeleteChat(ctx context.Context, groupId, chatId, memberId uint) (*entity.Chat, error)
+	PurgeChat(ctx context.Context, groupId, chatId, adminId uint) (*entity.Chat, error)
+	UpdateChat(ctx context.Context, groupId, chatId, memberId uint, message string) (*entity.Chat, error)
 
 	//write group & member
-	CreateGroup(ctx context.Context, name, desc string, userId uint) error
-	UpdateGroup(ctx context.Context, name, desc string, adminId, groupId uint) error
+	CreateGroup(ctx context.Context, name, desc, visibility string, userId uint) error
+	UpdateGroup(ctx context.Context, name, desc string, adminId, groupId uint, messageTTL *int64, visibility *string) error
 	DeleteGroup(ctx context.Context, adminId, groupId uint) error
-	AddMember(req []entity.GroupMember, adminId uint) error
+	AddMember(ctx context.Context, req []entity.GroupMember, adminId uint) error
 	RemoveMember(req []uint, adminId uint) error
 	ExitGroup(memberId uint) error
 	UpdateRoleUser(memberId, adminId, groupId uint, role string) error
 
 	//chat stream
-	AddChatStream(stream pb.ChatService_ChatStreamingServer) string
+	AddChatStream(groupId, threadId, userId uint, stream pb.ChatService_ChatStreamingServer) string
 	RemoveChatStream(clientID string)
 	ChatBroadcast(chat *entity.Chat, action int)
 
 	//status
+	SetTyping(ctx context.Context, groupId, memberId uint, typing bool) error
 	AddStatusStream(groupId, memberId uint, stream pb.ChatService_StatusStreamingServer) string
 	RemoveStatusStream(clientID string)
 
-	GetChatsByGroupID(groupID uint) ([]entity.Chat, error)
+	GetChatsByGroupID(userId, groupID uint) ([]entity.Chat, error)
 	GetMemberStatuses(groupId uint) ([]helper.MemberStatus, error)
 	GetGroupMemberID(ctx context.Context, userID, groupID uint) (bool, uint, error)
 	UpdateUnreadMessage(memberId uint) error
 	GetListGroup(userId uint) ([]helper.GroupInfo, error)
+
+	//attachment
+	UploadAttachment(ctx context.Context, info *helper.AttachmentInfo, content io.Reader) (*entity.Attachment, error)
+	GetAttachment(ctx context.Context, attachmentId uint) (*entity.Attachment, error)
+	OpenAttachment(ctx context.Context, attachment *entity.Attachment, thumbnailSize int) (io.ReadCloser, error)
+
+	//reaction
+	AddReaction(ctx context.Context, groupId, chatId, memberId uint, emoji string) (*entity.Chat, error)
+	RemoveReaction(ctx context.Context, groupId, chatId, memberId uint, emoji string) (*entity.Chat, error)
+	ReactionBroadcast(chat *entity.Chat, memberId uint)
+
+	//forward
+	ForwardMessages(ctx context.Context, userId, sourceGroupId uint, chatIds, targetGroupIds []uint) ([]entity.Chat, error)
+
+	//profile
+	ProfileBroadcast(ctx context.Context, user *entity.User)
+	IsAvatarAttachment(ctx context.Context, attachment *entity.Attachment) (bool, error)
+
+	//discovery
+	SearchPublicGroups(ctx context.Context, userId uint, query string, limit, offset int) ([]helper.GroupPreview, int64, error)
+	GetGroupPreview(ctx context.Context, userId, groupId uint) (*helper.GroupPreview, error)
+	JoinPublicGroup(ctx context.Context, userId, groupId uint) (*entity.GroupMember, error)
+
+	//invite
+	CreateInvite(ctx context.Context, groupId, adminId uint, role string, maxUses int, expiresIn time.Duration) (*entity.GroupInvite, error)
+	ListInvites(ctx context.Context, groupId, adminId uint) ([]entity.GroupInvite, error)
+	RevokeInvite(ctx context.Context, groupId, adminId uint, code string) error
+	JoinByInvite(ctx context.Context, userId uint, code string) (*entity.GroupMember, error)
+
+	//join request
+	RequestToJoin(ctx context.Context, userId, groupId uint, note string) (*entity.JoinRequest, error)
+	ListJoinRequests(ctx context.Context, groupId, adminId uint) ([]entity.JoinRequest, error)
+	ApproveJoinRequest(ctx context.Context, groupId, adminId, requestId uint) error
+	RejectJoinRequest(ctx context.Context, groupId, adminId, requestId uint) error
+
+	//scheduled message
+	ScheduleMessage(ctx context.Context, groupId, memberId, userId uint, message string, sendAt time.Time) (*entity.ScheduledMessage, error)
+	ListScheduledMessages(ctx context.Context, userId, groupId uint) ([]entity.ScheduledMessage, error)
+	CancelScheduledMessage(ctx context.Context, id, userId uint) error
+
+	//revision
+	GetMessageRevisions(ctx context.Context, userId, groupId, chatId, memberId uint) (*entity.Chat, []entity.ChatRevision, error)
+
+	//thread
+	GetThread(ctx context.Context, userId, groupId, chatId uint) (*entity.Chat, []entity.Chat, error)
+
+	//pin
+	PinMessage(ctx context.Context, groupId, chatId, adminId uint) (*entity.Chat, error)
+	UnpinMessage(ctx context.Context, groupId, chatId, adminId uint) (*entity.Chat, error)
+	ListPinnedMessages(ctx context.Context, userId, groupId uint) ([]entity.Chat, error)
+
+	//search
+	SearchMessages(ctx context.Context, req *helper.SearchMessagesReq) ([]helper.SearchHit, int, error)
+
+	//mention
+	ListMentions(ctx context.Context, userId, groupId uint, unreadOnly bool, limit int) ([]entity.Mention, error)
+
+	ThreadBroadcast(ctx context.Context, chat *entity.Chat)
 }
 
 type StreamStatus struct {
@@ -50,49 +114,184 @@ type StreamStatus struct {
 	Stream   pb.ChatService_StatusStreamingServer
 }
 
+type StreamChat struct {
+	GroupId uint
+	// 0 untuk semua chat grup, selain itu hanya root thread dan balasannya
+	ThreadId uint
+	// user pemilik stream, untuk menandai chat dari user yang dia blokir
+	UserId uint
+	Stream pb.ChatService_ChatStreamingServer
+}
+
 type chatUsecase struct {
-	chatRepo            repository.ChatRepo
+	chatRepo    repository.ChatRepo
+	storage     storage.Storage
+	searchIndex search.Index
+	// indexTouched tidak nil selama rebuild index saat startup
+	indexMu             sync.Mutex
+	indexTouched        map[uint]bool
+	maxAttachmentSize   int64
+	thumbnailSizes      []int
+	maxReactionsPerChat int
+	maxPinsPerGroup     int
+	attachmentJobs      chan uint
 	mu                  sync.RWMutex
-	streamChat          map[string]pb.ChatService_ChatStreamingServer
+	streamChat          map[string]*StreamChat
 	streamStatusOnGroup map[string]*StreamStatus
+
+	// typing indicator, hanya di memory
+	typingMu          sync.Mutex
+	typing            map[uint]*typingState
+	typingLastCall    map[uint]time.Time
+	typingTimeout     time.Duration
+	typingMinInterval time.Duration
 }
 
-func NewChatUsecase(r repository.ChatRepo) ChatUsecase {
-	return &chatUsecase{
+func NewChatUsecase(r repository.ChatRepo, store storage.Storage, index search.Index) ChatUsecase {
+	u := &chatUsecase{
 		chatRepo:            r,
-		streamChat:          make(map[string]pb.ChatService_ChatStreamingServer),
+		storage:             store,
+		searchIndex:         index,
+		maxAttachmentSize:   envInt64("ATTACHMENT_MAX_SIZE", defaultMaxAttachmentSize),
+		thumbnailSizes:      thumbnailSizes(),
+		maxReactionsPerChat: int(envInt64("MAX_REACTIONS_PER_CHAT", defaultMaxReactionsPerChat)),
+		maxPinsPerGroup:     int(envInt64("MAX_PINS_PER_GROUP", defaultMaxPinsPerGroup)),
+		attachmentJobs:      make(chan uint, 256),
+		streamChat:          make(map[string]*StreamChat),
 		streamStatusOnGroup: make(map[string]*StreamStatus),
+		typing:              make(map[uint]*typingState),
+		typingLastCall:      make(map[uint]time.Time),
+		typingTimeout:       time.Duration(envInt64("TYPING_TIMEOUT_MS", defaultTypingTimeout.Milliseconds())) * time.Millisecond,
+		typingMinInterval:   time.Duration(envInt64("TYPING_MIN_INTERVAL_MS", defaultTypingMinInterval.Milliseconds())) * time.Millisecond,
+		indexTouched:        make(map[uint]bool),
 	}
+	u.startAttachmentPipeline(int(envInt64("ATTACHMENT_WORKERS", 2)))
+	go u.rebuildSearchIndex()
+	u.startJanitor(time.Duration(envInt64("EXPIRY_JANITOR_INTERVAL_MS", defaultJanitorInterval.Milliseconds())) * time.Millisecond)
+	u.startScheduler(time.Duration(envInt64("SCHEDULER_INTERVAL_MS", defaultSchedulerInterval.Milliseconds())) * time.Millisecond)
+	return u
 }
 
 // write chat
 func (u *chatUsecase) CreateChat(ctx context.Context, req *helper.CreateChatReq) (*entity.Chat, error) {
-	return u.chatRepo.CreateChat(ctx, req)
+	if req.Message == "" && len(req.AttachmentIDs) == 0 {
+		return nil, apperror.Invalid("message or attachment is required", apperror.FieldViolation{
+			Field:       "message",
+			Description: "value length must be at least 1 runes when there is no attachment",
+		})
+	}
+
+	mentionIds, err := u.resolveMentions(req.GroupId, req.MemberId, req.Message)
+	if err != nil {
+		return nil, err
+	}
+	req.MentionIDs = mentionIds
+
+	chat, err := u.chatRepo.CreateChat(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	u.indexChat(chat)
+	u.stopTyping(req.MemberId)
+	return chat, nil
 }
 
-func (u *chatUsecase) DeleteChat(ctx context.Context, chatId uint) (*entity.Chat, error) {
-	return u.chatRepo.DeleteChat(ctx, chatId)
+// DeleteChat soft-deletes a chat; only its sender or an admin may do so
+func (u *chatUsecase) DeleteChat(ctx context.Context, groupId, chatId, memberId uint) (*entity.Chat, error) {
+	chat, err := u.getChatInGroup(ctx, groupId, chatId)
+	if err != nil {
+		return nil, err
+	}
+	if chat.GroupMemberID == nil || *chat.GroupMemberID != memberId {
+		isAdmin, err := u.chatRepo.IsMemberAdmin(memberId)
+		if err != nil {
+			return nil, err
+		}
+		if !isAdmin {
+			return nil, apperror.PermissionDenied("only the sender or an admin can delete this chat")
+		}
+	}
+
+	chat, err = u.chatRepo.DeleteChat(ctx, chatId, memberId)
+	if err != nil {
+		return nil, err
+	}
+	u.unindexChat(chat.ID)
+	u.stopTyping(memberId)
+	return chat, nil
 }
 
-func (u *chatUsecase) UpdateChat(ctx context.Context, chatId uint, message string) (*entity.Chat, error) {
-	return u.chatRepo.UpdateChat(ctx, chatId, message)
+// PurgeChat permanently removes a chat, including tombstones, and its blobs
+func (u *chatUsecase) PurgeChat(ctx context.Context, groupId, chatId, adminId uint) (*entity.Chat, error) {
+	if err := u.checkAdmin(adminId); err != nil {
+		return nil, err
+	}
+	if _, err := u.findChatInGroup(ctx, groupId, chatId); err != nil {
+		return nil, err
+	}
+
+	return u.purgeChat(ctx, chatId)
+}
+
+// purgeChat deletes the chat permanently together with its blobs
+func (u *chatUsecase) purgeChat(ctx context.Context, chatId uint) (*entity.Chat, error) {
+	chat, err := u.chatRepo.PurgeChat(ctx, chatId)
+	if err != nil {
+		return nil, err
+	}
+	u.unindexChat(chat.ID)
+
+	// baris attachment ikut terhapus (cascade), blob-nya dihapus di sini
+	for _, a := range chat.Attachments {
+		u.deleteBlob(a.StorageKey)
+		for _, t := range a.Thumbnails {
+			u.deleteBlob(t.StorageKey)
+		}
+	}
+	return chat, nil
+}
+
+func (u *chatUsecase) UpdateChat(ctx context.Context, groupId, chatId, memberId uint, message string) (*entity.Chat, error) {
+	chat, err := u.getChatInGroup(ctx, groupId, chatId)
+	if err != nil {
+		return nil, err
+	}
+	// hanya pengirim, admin pun tidak boleh mengubah isi chat member lain
+	if chat.GroupMemberID == nil || *chat.GroupMemberID != memberId {
+		return nil, apperror.PermissionDenied("only the sender can edit this chat")
+	}
+
+	mentionIds, err := u.resolveMentions(groupId, memberId, message)
+	if err != nil {
+		return nil, err
+	}
+
+	chat, err = u.chatRepo.UpdateChat(ctx, chatId, memberId, message, mentionIds)
+	if err != nil {
+		return nil, err
+	}
+	u.indexChat(chat)
+	return chat, nil
 }
 
 // write group & member
-func (u *chatUsecase) CreateGroup(ctx context.Context, name, desc string, userId uint) error {
-	return u.chatRepo.CreateGroup(ctx, name, desc, userId)
+func (u *chatUsecase) CreateGroup(ctx context.Context, name, desc, visibility string, userId uint) error {
+	if visibility == "" {
+		visibility = entity.GroupPrivate
+	}
+	return u.chatRepo.CreateGroup(ctx, name, desc, visibility, userId)
 }
 
-func (u *chatUsecase) UpdateGroup(ctx context.Context, name, desc string, adminId, groupId uint) error {
+func (u *chatUsecase) UpdateGroup(ctx context.Context, name, desc string, adminId, groupId uint, messageTTL *int64, visibility *string) error {
 	valid, err := u.chatRepo.IsMemberAdmin(adminId)
 	if err != nil {
 		return err
 	}
 	if !valid {
-		return fmt.Errorf("you arent admin")
+		return apperror.PermissionDenied("you arent admin")
 	}
 
-	return u.chatRepo.UpdateGroup(ctx, name, desc, groupId)
+	return u.chatRepo.UpdateGroup(ctx, name, desc, groupId, messageTTL, visibility)
 }
 
 func (u *chatUsecase) DeleteGroup(ctx context.Context, adminId uint, groupId uint) error {
@@ -101,20 +300,28 @@ func (u *chatUsecase) DeleteGroup(ctx context.Context, adminId uint, groupId uin
 		return err
 	}
 	if !valid {
-		return fmt.Errorf("you arent admin")
+		return apperror.PermissionDenied("you arent admin")
 	}
 
 	return u.chatRepo.DeleteGroup(ctx, groupId)
 
 }
 
-func (u *chatUsecase) AddMember(req []entity.GroupMember, adminId uint) error {
+func (u *chatUsecase) AddMember(ctx context.Context, req []entity.GroupMember, adminId uint) error {
 	valid, err := u.chatRepo.IsMemberAdmin(adminId)
 	if err != nil {
 		return err
 	}
 	if !valid {
-		return fmt.Errorf("you arent admin")
+		return apperror.PermissionDenied("you arent admin")
+	}
+
+	userIds := make([]uint, 0, len(req))
+	for _, m := range req {
+		userIds = append(userIds, m.UserID)
+	}
+	if err := u.checkNotBlocked(ctx, adminId, userIds); err != nil {
+		return err
 	}
 
 	return u.chatRepo.AddMember(req)
@@ -126,7 +333,7 @@ func (u *chatUsecase) RemoveMember(req []uint, adminId uint) error {
 		return err
 	}
 	if !valid {
-		return fmt.Errorf("you arent admin")
+		return apperror.PermissionDenied("you arent admin")
 	}
 
 	return u.chatRepo.RemoveMember(req)
@@ -142,7 +349,7 @@ func (u *chatUsecase) UpdateRoleUser(memberId, adminId, groupId uint, role strin
 		return err
 	}
 	if !valid {
-		return fmt.Errorf("you arent admin")
+		return apperror.PermissionDenied("you arent admin")
 	}
 
 	return u.chatRepo.UpdateRoleUser(memberId, role)
@@ -153,18 +360,30 @@ func (u *chatUsecase) GetGroupMemberID(ctx context.Context, userID, groupID uint
 	return u.chatRepo.GetGroupMemberID(ctx, userID, groupID)
 }
 
-func (u *chatUsecase) GetChatsByGroupID(chatGroupID uint) ([]entity.Chat, error) {
-	return u.chatRepo.GetChatsByGroupID(chatGroupID)
+func (u *chatUsecase) GetChatsByGroupID(userId, chatGroupID uint) ([]entity.Chat, error) {
+	chats, err := u.chatRepo.GetChatsByGroupID(chatGroupID)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.markBlockedSenders(context.Background(), userId, chatGroupID, chatPointers(chats)...); err != nil {
+		return nil, err
+	}
+	return chats, nil
 }
 
 // chat stream
-func (u *chatUsecase) AddChatStream(stream pb.ChatService_ChatStreamingServer) string {
+func (u *chatUsecase) AddChatStream(groupId, threadId, userId uint, stream pb.ChatService_ChatStreamingServer) string {
 	clientID := fmt.Sprintf("%d", time.Now().UnixNano())
 
 	u.mu.Lock()
 	defer u.mu.Unlock()
 
-	u.streamChat[clientID] = stream
+	u.streamChat[clientID] = &StreamChat{
+		GroupId:  groupId,
+		ThreadId: threadId,
+		UserId:   userId,
+		Stream:   stream,
+	}
 
 	return clientID
 }
@@ -177,19 +396,28 @@ func (u *chatUsecase) RemoveChatStream(clientID string) {
 }
 
 func (u *chatUsecase) ChatBroadcast(chat *entity.Chat, action int) {
+	u.chatBroadcastFlagged(chat, helper.ConvertChatToPbStream(chat, pb.Action(action)))
+}
+
+// groupBroadcast sends an event to every chat stream subscribed to the group
+func (u *chatUsecase) groupBroadcast(groupId uint, res *pb.ChatStreamingResponse) {
+	u.groupSend(groupId, func(*StreamChat) *pb.ChatStreamingResponse { return res })
+}
+
+// groupSend is groupBroadcast with the event picked per client
+func (u *chatUsecase) groupSend(groupId uint, pick func(client *StreamChat) *pb.ChatStreamingResponse) {
 	u.mu.Lock()
 	defer u.mu.Unlock()
-	result := helper.ConvertChatToPbResponse(chat)
-	for clientId, stream := range u.streamChat {
-		err := stream.Send(&pb.ChatStreamingResponse{
-			Member:     uint64(*chat.GroupMemberID),
-			Username:   chat.GroupMember.User.Username,
-			Message:    chat.Message,
-			Timestamp:  chat.CreatedAt.Format(time.RFC3339),
-			Action:     pb.Action(action),
-			ReadStatus: result,
-		})
+	for clientId, client := range u.streamChat {
+		if client.GroupId != groupId {
+			continue
+		}
+		res := pick(client)
+		if client.ThreadId != 0 && res.ChatId != uint64(client.ThreadId) && res.ThreadRootId != uint64(client.ThreadId) {
+			continue
+		}
 
+		err := client.Stream.Send(res)
 		if err != nil {
 			log.Printf("error sending to client %s: %v, removing client", clientId, err)
 			delete(u.streamChat, clientId)
@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"chat_api/entity"
+	"chat_api/service/repository"
+	"chat_api/utils/apperror"
+	"chat_api/utils/search"
+	"chat_api/utils/testdb"
+	"context"
+	"testing"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// newTestChatUsecase builds the usecase without the background workers
+// started by NewChatUsecase
+func newTestChatUsecase(t *testing.T) (*chatUsecase, *gorm.DB) {
+	t.Helper()
+	db := testdb.Open(t)
+	u := &chatUsecase{
+		chatRepo:            repository.NewChatRepo(db),
+		searchIndex:         search.NewMemoryIndex(),
+		maxReactionsPerChat: defaultMaxReactionsPerChat,
+		maxPinsPerGroup:     defaultMaxPinsPerGroup,
+		streamChat:          make(map[string]*StreamChat),
+		streamStatusOnGroup: make(map[string]*StreamStatus),
+		typing:              make(map[uint]*typingState),
+		typingLastCall:      make(map[uint]time.Time),
+	}
+	return u, db
+}
+
+func TestUpdateChatOnlySender(t *testing.T) {
+	u, db := newTestChatUsecase(t)
+	ctx := context.Background()
+
+	group := testdb.Group(t, db, "g", entity.GroupPrivate)
+	other := testdb.Group(t, db, "other", entity.GroupPrivate)
+	sender := testdb.Member(t, db, group.ID, testdb.User(t, db, "sender").ID, "member")
+	admin := testdb.Member(t, db, group.ID, testdb.User(t, db, "admin").ID, "admin")
+	chat := testdb.Chat(t, db, group.ID, sender.ID, "asli")
+
+	tests := []struct {
+		name     string
+		groupId  uint
+		memberId uint
+		want     apperror.Kind
+	}{
+		{name: "admin is not the sender", groupId: group.ID, memberId: admin.ID, want: apperror.KindPermissionDenied},
+		{name: "chat from another group", groupId: other.ID, memberId: sender.ID, want: apperror.KindNotFound},
+	}
+	for _, tt := range tests {
+		_, err := u.UpdateChat(ctx, tt.groupId, chat.ID, tt.memberId, "diubah")
+		if got := apperror.KindOf(err); err == nil || got != tt.want {
+			t.Errorf("%s: err = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+
+	var stored entity.Chat
+	db.First(&stored, chat.ID)
+	if stored.Message != "asli" {
+		t.Fatalf("rejected edit changed the message to %q", stored.Message)
+	}
+
+	updated, err := u.UpdateChat(ctx, group.ID, chat.ID, sender.ID, "diubah")
+	if err != nil {
+		t.Fatalf("sender edit: %v", err)
+	}
+	if updated.Message != "diubah" {
+		t.Errorf("message = %q, want %q", updated.Message, "diubah")
+	}
+}
@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"chat_api/entity"
+	"chat_api/utils/apperror"
+	"chat_api/utils/helper"
+	"context"
+)
+
+const (
+	defaultGroupSearchLimit = 20
+)
+
+func (u *chatUsecase) SearchPublicGroups(ctx context.Context, userId uint, query string, limit, offset int) ([]helper.GroupPreview, int64, error) {
+	if limit <= 0 {
+		limit = defaultGroupSearchLimit
+	}
+	return u.chatRepo.SearchPublicGroups(ctx, userId, query, limit, offset)
+}
+
+// GetGroupPreview hides private groups from non-members
+func (u *chatUsecase) GetGroupPreview(ctx context.Context, userId, groupId uint) (*helper.GroupPreview, error) {
+	group, err := u.chatRepo.GetGroupPreview(ctx, userId, groupId)
+	if err != nil {
+		return nil, err
+	}
+	if group.Visibility != entity.GroupPublic && !group.IsMember {
+		return nil, apperror.NotFound("group not found")
+	}
+	return group, nil
+}
+
+func (u *chatUsecase) JoinPublicGroup(ctx context.Context, userId, groupId uint) (*entity.GroupMember, error) {
+	member, err := u.chatRepo.JoinPublicGroup(ctx, groupId, userId)
+	if err != nil {
+		return nil, err
+	}
+	u.joinBroadcast(member)
+	return member, nil
+}
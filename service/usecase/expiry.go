@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"chat_api/entity"
+	"chat_api/pb"
+	"context"
+	"log"
+	"time"
+)
+
+const (
+	defaultJanitorInterval = 10 * time.Second
+	expiredBatchSize       = 100
+)
+
+func isExpired(chat *entity.Chat) bool {
+	return chat.ExpiresAt != nil && !chat.ExpiresAt.After(time.Now())
+}
+
+// startJanitor permanently deletes expired chats every interval and tells the
+// online clients to remove them
+func (u *chatUsecase) startJanitor(interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for range ticker.C {
+			u.purgeExpiredChats()
+		}
+	}()
+}
+
+func (u *chatUsecase) purgeExpiredChats() {
+	ctx := context.Background()
+	// keyset per id supaya chat yang gagal di-purge tidak menahan batch berikutnya,
+	// chat itu dicoba lagi di tick selanjutnya
+	lastId := uint(0)
+	for {
+		ids, err := u.chatRepo.GetExpiredChatIDs(ctx, time.Now().UTC(), lastId, expiredBatchSize)
+		if err != nil {
+			log.Printf("failed to load expired chats: %v", err)
+			return
+		}
+		for _, id := range ids {
+			lastId = id
+			chat, err := u.purgeChat(ctx, id)
+			if err != nil {
+				log.Printf("failed to purge expired chat %d: %v", id, err)
+				continue
+			}
+			u.ChatBroadcast(chat, int(pb.Action_Purge))
+			u.ThreadBroadcast(ctx, chat)
+		}
+		if len(ids) < expiredBatchSize {
+			return
+		}
+	}
+}
@@ -0,0 +1,188 @@
+package usecase
+
+import (
+	"chat_api/entity"
+	"chat_api/utils/apperror"
+	"chat_api/utils/helper"
+	"context"
+	"fmt"
+	"sort"
+)
+
+// ForwardMessages copies chats of sourceGroupId, with their attachments, into
+// every target group. The caller must be a member of the source group (checked
+// by the handler) and of every target group. The copies keep the original
+// author and group as attribution; forwarding a forward keeps the first one.
+func (u *chatUsecase) ForwardMessages(ctx context.Context, userId, sourceGroupId uint, chatIds, targetGroupIds []uint) ([]entity.Chat, error) {
+	targetMembers := make(map[uint]uint, len(targetGroupIds))
+	for _, groupId := range targetGroupIds {
+		ismember, memberId, err := u.chatRepo.GetGroupMemberID(ctx, userId, groupId)
+		if err != nil {
+			return nil, err
+		}
+		if !ismember {
+			return nil, apperror.PermissionDenied(fmt.Sprintf("you arent member of group %d", groupId))
+		}
+		targetMembers[groupId] = memberId
+	}
+
+	sources, err := u.chatRepo.GetChatsByIDs(ctx, chatIds)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range sources {
+		if c.GroupID != sourceGroupId {
+			return nil, apperror.NotFound("chat not found")
+		}
+	}
+	if len(sources) != len(chatIds) {
+		return nil, apperror.NotFound("chat not found")
+	}
+	// urutan tetap sesuai waktu kirim, bukan urutan di request
+	sort.Slice(sources, func(i, j int) bool {
+		if !sources[i].CreatedAt.Equal(sources[j].CreatedAt) {
+			return sources[i].CreatedAt.Before(sources[j].CreatedAt)
+		}
+		return sources[i].ID < sources[j].ID
+	})
+
+	group, err := u.chatRepo.GetGroup(ctx, sourceGroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	readers := make(map[uint][]helper.StatusUser, len(targetGroupIds))
+	for _, groupId := range targetGroupIds {
+		statuses, err := u.GetMemberStatuses(groupId)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range statuses {
+			readers[groupId] = append(readers[groupId], helper.StatusUser{MemberId: s.MemberID, Status: s.Status})
+		}
+	}
+
+	// blob disalin ke key baru supaya purge di satu grup tidak menghapus file grup lain
+	var copiedKeys []string
+	cleanup := func() {
+		for _, key := range copiedKeys {
+			u.deleteBlob(key)
+		}
+	}
+
+	var copies []entity.Chat
+	for _, groupId := range targetGroupIds {
+		memberId := targetMembers[groupId]
+		for i := range sources {
+			chat := forwardCopy(&sources[i], group, groupId, memberId)
+			for _, a := range sources[i].Attachments {
+				attachment, keys, err := u.copyAttachment(ctx, a, groupId, memberId)
+				copiedKeys = append(copiedKeys, keys...)
+				if err != nil {
+					cleanup()
+					return nil, err
+				}
+				chat.Attachments = append(chat.Attachments, attachment)
+			}
+			copies = append(copies, chat)
+		}
+	}
+
+	chats, err := u.chatRepo.ForwardChats(ctx, copies, readers)
+	if err != nil {
+		cleanup()
+		return nil, err
+	}
+
+	for i := range chats {
+		u.indexChat(&chats[i])
+		for _, a := range chats[i].Attachments {
+			if a.Status == entity.AttachmentProcessing {
+				u.enqueueAttachment(a.ID)
+			}
+		}
+	}
+	return chats, nil
+}
+
+func forwardCopy(source *entity.Chat, sourceGroup *entity.ChatGroup, groupId, memberId uint) entity.Chat {
+	chat := entity.Chat{
+		GroupMemberID: &memberId,
+		GroupID:       groupId,
+		Message:       source.Message,
+	}
+
+	if source.ForwardedFromChatID != nil {
+		chat.ForwardedFromChatID = source.ForwardedFromChatID
+		chat.ForwardedFromGroupID = source.ForwardedFromGroupID
+		chat.ForwardedFromUserID = source.ForwardedFromUserID
+		chat.ForwardedFromUsername = source.ForwardedFromUsername
+		chat.ForwardedFromGroupName = source.ForwardedFromGroupName
+		return chat
+	}
+
+	chat.ForwardedFromChatID = &source.ID
+	chat.ForwardedFromGroupID = &sourceGroup.ID
+	chat.ForwardedFromGroupName = sourceGroup.Name
+	if source.GroupMember != nil {
+		chat.ForwardedFromUserID = &source.GroupMember.UserID
+		chat.ForwardedFromUsername = source.GroupMember.User.Username
+	}
+	return chat
+}
+
+// copyAttachment copies the blob and thumbnails of an attachment to new keys
+// and returns the new (unsaved) attachment and every key that was written.
+func (u *chatUsecase) copyAttachment(ctx context.Context, source entity.Attachment, groupId, memberId uint) (entity.Attachment, []string, error) {
+	var keys []string
+
+	key, err := newStorageKey()
+	if err != nil {
+		return entity.Attachment{}, keys, apperror.Wrap(apperror.KindInternal, "internal server error", err)
+	}
+	if err := u.copyBlob(ctx, source.StorageKey, key); err != nil {
+		return entity.Attachment{}, keys, err
+	}
+	keys = append(keys, key)
+
+	attachment := entity.Attachment{
+		GroupID:       groupId,
+		GroupMemberID: &memberId,
+		FileName:      source.FileName,
+		ContentType:   source.ContentType,
+		Size:          source.Size,
+		Checksum:      source.Checksum,
+		StorageKey:    key,
+		Width:         source.Width,
+		Height:        source.Height,
+		Blurhash:      source.Blurhash,
+		Status:        source.Status,
+	}
+	for _, t := range source.Thumbnails {
+		thumbKey := fmt.Sprintf("%s_%d.jpg", key, t.Size)
+		if err := u.copyBlob(ctx, t.StorageKey, thumbKey); err != nil {
+			return entity.Attachment{}, keys, err
+		}
+		keys = append(keys, thumbKey)
+		attachment.Thumbnails = append(attachment.Thumbnails, entity.AttachmentThumbnail{
+			Size:       t.Size,
+			Width:      t.Width,
+			Height:     t.Height,
+			StorageKey: thumbKey,
+		})
+	}
+	return attachment, keys, nil
+}
+
+func (u *chatUsecase) copyBlob(ctx context.Context, from, to string) error {
+	r, err := u.storage.Open(ctx, from)
+	if err != nil {
+		return apperror.Wrap(apperror.KindInternal, "internal server error", err)
+	}
+	defer r.Close()
+
+	if _, err := u.storage.Put(ctx, to, r); err != nil {
+		return apperror.Wrap(apperror.KindInternal, "internal server error", err)
+	}
+	return nil
+}
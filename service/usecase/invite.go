@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"chat_api/entity"
+	"chat_api/pb"
+	"chat_api/utils/apperror"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"log"
+	"time"
+)
+
+func (u *chatUsecase) CreateInvite(ctx context.Context, groupId, adminId uint, role string, maxUses int, expiresIn time.Duration) (*entity.GroupInvite, error) {
+	if err := u.checkAdmin(adminId); err != nil {
+		return nil, err
+	}
+	if role == "" {
+		role = "member"
+	}
+
+	code, err := newInviteCode()
+	if err != nil {
+		return nil, apperror.Wrap(apperror.KindInternal, "internal server error", err)
+	}
+
+	invite := &entity.GroupInvite{
+		Code:          code,
+		GroupID:       groupId,
+		GroupMemberID: &adminId,
+		Role:          role,
+		MaxUses:       maxUses,
+	}
+	if expiresIn > 0 {
+		expiresAt := time.Now().UTC().Add(expiresIn)
+		invite.ExpiresAt = &expiresAt
+	}
+	if err := u.chatRepo.CreateInvite(ctx, invite); err != nil {
+		return nil, err
+	}
+	return invite, nil
+}
+
+func (u *chatUsecase) ListInvites(ctx context.Context, groupId, adminId uint) ([]entity.GroupInvite, error) {
+	if err := u.checkAdmin(adminId); err != nil {
+		return nil, err
+	}
+	return u.chatRepo.ListInvites(ctx, groupId)
+}
+
+func (u *chatUsecase) RevokeInvite(ctx context.Context, groupId, adminId uint, code string) error {
+	if err := u.checkAdmin(adminId); err != nil {
+		return err
+	}
+	return u.chatRepo.RevokeInvite(ctx, groupId, code)
+}
+
+// JoinByInvite adds the user to the group of the invite and tells the
+// online members
+func (u *chatUsecase) JoinByInvite(ctx context.Context, userId uint, code string) (*entity.GroupMember, error) {
+	member, err := u.chatRepo.JoinByInvite(ctx, code, userId)
+	if err != nil {
+		return nil, err
+	}
+	u.joinBroadcast(member)
+	return member, nil
+}
+
+func (u *chatUsecase) joinBroadcast(member *entity.GroupMember) {
+	username, err := u.memberUsername(member.GroupID, member.ID)
+	if err != nil {
+		log.Printf("failed to load member %d: %v", member.ID, err)
+		return
+	}
+	u.groupBroadcast(member.GroupID, &pb.ChatStreamingResponse{
+		Member:    uint64(member.ID),
+		Username:  username,
+		GroupId:   uint64(member.GroupID),
+		Timestamp: time.Now().Format(time.RFC3339),
+		Action:    pb.Action_Join,
+	})
+}
+
+// newInviteCode returns 16 url-safe characters
+func newInviteCode() (string, error) {
+	b := make([]byte, 12)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
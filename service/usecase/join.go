@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"chat_api/entity"
+	"chat_api/pb"
+	"chat_api/utils/apperror"
+	"chat_api/utils/helper"
+	"context"
+	"log"
+)
+
+// RequestToJoin stores a pending request and notifies the admins connected
+// to the status stream of the group
+func (u *chatUsecase) RequestToJoin(ctx context.Context, userId, groupId uint, note string) (*entity.JoinRequest, error) {
+	// grup yang tidak ada dijawab "group not found", sama dengan GetGroupPreview
+	group, err := u.chatRepo.GetGroup(ctx, groupId)
+	if err != nil {
+		return nil, err
+	}
+	if group.Visibility == entity.GroupPublic {
+		return nil, apperror.Conflict("group is public, join it with JoinPublicGroup")
+	}
+
+	req := &entity.JoinRequest{
+		GroupID: groupId,
+		UserID:  userId,
+		Note:    note,
+	}
+	if err := u.chatRepo.CreateJoinRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	u.joinRequestBroadcast(ctx, req, pb.StatusEvent_JoinRequested)
+	return req, nil
+}
+
+func (u *chatUsecase) ListJoinRequests(ctx context.Context, groupId, adminId uint) ([]entity.JoinRequest, error) {
+	if err := u.checkAdmin(adminId); err != nil {
+		return nil, err
+	}
+	return u.chatRepo.ListJoinRequests(ctx, groupId)
+}
+
+func (u *chatUsecase) ApproveJoinRequest(ctx context.Context, groupId, adminId, requestId uint) error {
+	return u.reviewJoinRequest(ctx, groupId, adminId, requestId, true)
+}
+
+func (u *chatUsecase) RejectJoinRequest(ctx context.Context, groupId, adminId, requestId uint) error {
+	return u.reviewJoinRequest(ctx, groupId, adminId, requestId, false)
+}
+
+func (u *chatUsecase) reviewJoinRequest(ctx context.Context, groupId, adminId, requestId uint, approve bool) error {
+	if err := u.checkAdmin(adminId); err != nil {
+		return err
+	}
+
+	req, member, err := u.chatRepo.ReviewJoinRequest(ctx, groupId, requestId, adminId, approve)
+	if err != nil {
+		return err
+	}
+
+	// admin lain perlu tahu request ini sudah tidak pending
+	u.joinRequestBroadcast(ctx, req, pb.StatusEvent_JoinRequestResolved)
+	if member != nil {
+		u.joinBroadcast(member)
+	}
+	return nil
+}
+
+// joinRequestBroadcast sends a join request event to the status streams of
+// the admins of the group only
+func (u *chatUsecase) joinRequestBroadcast(ctx context.Context, req *entity.JoinRequest, event pb.StatusEvent) {
+	adminIds, err := u.chatRepo.GetGroupAdminIDs(ctx, req.GroupID)
+	if err != nil {
+		log.Printf("failed to load admins of group %d: %v", req.GroupID, err)
+		return
+	}
+	admins := make(map[uint]bool, len(adminIds))
+	for _, id := range adminIds {
+		admins[id] = true
+	}
+
+	res := &pb.StatusStreamingResponse{
+		Event:       event,
+		JoinRequest: helper.ConvertJoinRequestToPb(req),
+	}
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	for clientId, client := range u.streamStatusOnGroup {
+		if client.GroupId != req.GroupID || !admins[client.memberId] {
+			continue
+		}
+		if err := client.Stream.Send(res); err != nil {
+			log.Printf("error sending status to client %s: %v, removing client", clientId, err)
+			delete(u.streamStatusOnGroup, clientId)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"chat_api/entity"
+	"chat_api/utils/apperror"
+	"chat_api/utils/testdb"
+	"context"
+	"testing"
+)
+
+func TestRequestToJoin(t *testing.T) {
+	u, db := newTestChatUsecase(t)
+	ctx := context.Background()
+
+	public := testdb.Group(t, db, "public", entity.GroupPublic)
+	private := testdb.Group(t, db, "private", entity.GroupPrivate)
+	user := testdb.User(t, db, "alice")
+
+	tests := []struct {
+		name    string
+		groupId uint
+		wantErr bool
+		want    apperror.Kind
+	}{
+		{name: "public group joins directly", groupId: public.ID, wantErr: true, want: apperror.KindConflict},
+		{name: "missing group", groupId: 999, wantErr: true, want: apperror.KindNotFound},
+		{name: "private group", groupId: private.ID},
+		{name: "pending request", groupId: private.ID, wantErr: true, want: apperror.KindAlreadyExists},
+	}
+	for _, tt := range tests {
+		req, err := u.RequestToJoin(ctx, user.ID, tt.groupId, "halo")
+		if !tt.wantErr {
+			if err != nil {
+				t.Fatalf("%s: unexpected error %v", tt.name, err)
+			}
+			if req.GroupID != tt.groupId || req.UserID != user.ID {
+				t.Errorf("%s: request = %+v", tt.name, req)
+			}
+			continue
+		}
+		if got := apperror.KindOf(err); got != tt.want {
+			t.Errorf("%s: error kind = %v (%v), want %v", tt.name, got, err, tt.want)
+		}
+	}
+
+	var count int64
+	db.Model(&entity.JoinRequest{}).Where("group_id = ?", public.ID).Count(&count)
+	if count != 0 {
+		t.Errorf("public group has %d join requests, want 0", count)
+	}
+}
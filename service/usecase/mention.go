@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"chat_api/entity"
+	"chat_api/utils/apperror"
+	"chat_api/utils/helper"
+	"context"
+)
+
+const defaultMentionLimit = 50
+
+// resolveMentions turns the @username mentions in message into member ids of
+// the group. @all mentions every member and is only allowed for admins. The
+// sender is never mentioned.
+func (u *chatUsecase) resolveMentions(groupId, senderId uint, message string) ([]uint, error) {
+	members, err := u.chatRepo.GetMemberGroup(groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	memberIds, all := helper.ParseMentions(message, members)
+	if all {
+		isAdmin, err := u.chatRepo.IsMemberAdmin(senderId)
+		if err != nil {
+			return nil, err
+		}
+		if !isAdmin {
+			return nil, apperror.PermissionDenied("only admin can mention @all")
+		}
+
+		memberIds = memberIds[:0]
+		for _, m := range members {
+			memberIds = append(memberIds, m.ID)
+		}
+	}
+
+	var result []uint
+	for _, id := range memberIds {
+		if id != senderId {
+			result = append(result, id)
+		}
+	}
+	return result, nil
+}
+
+func (u *chatUsecase) ListMentions(ctx context.Context, userId, groupId uint, unreadOnly bool, limit int) ([]entity.Mention, error) {
+	if limit <= 0 {
+		limit = defaultMentionLimit
+	}
+	mentions, err := u.chatRepo.ListMentions(ctx, userId, groupId, unreadOnly, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	chats := make([]*entity.Chat, 0, len(mentions))
+	for _, m := range mentions {
+		chats = append(chats, m.Chat)
+	}
+	if err := u.markBlockedSenders(ctx, userId, groupId, chats...); err != nil {
+		return nil, err
+	}
+	return mentions, nil
+}
@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"chat_api/entity"
+	"chat_api/utils/apperror"
+	"context"
+)
+
+const defaultMaxPinsPerGroup = 10
+
+func (u *chatUsecase) PinMessage(ctx context.Context, groupId, chatId, adminId uint) (*entity.Chat, error) {
+	if err := u.checkAdmin(adminId); err != nil {
+		return nil, err
+	}
+	if _, err := u.getChatInGroup(ctx, groupId, chatId); err != nil {
+		return nil, err
+	}
+
+	pin := &entity.Pin{
+		ChatID:        chatId,
+		GroupID:       groupId,
+		GroupMemberID: &adminId,
+	}
+	if err := u.chatRepo.PinChat(ctx, pin, u.maxPinsPerGroup); err != nil {
+		return nil, err
+	}
+
+	return u.chatRepo.GetChat(ctx, chatId)
+}
+
+func (u *chatUsecase) UnpinMessage(ctx context.Context, groupId, chatId, adminId uint) (*entity.Chat, error) {
+	if err := u.checkAdmin(adminId); err != nil {
+		return nil, err
+	}
+	chat, err := u.getChatInGroup(ctx, groupId, chatId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.chatRepo.UnpinChat(ctx, chatId); err != nil {
+		return nil, err
+	}
+	chat.Pin = nil
+	return chat, nil
+}
+
+func (u *chatUsecase) ListPinnedMessages(ctx context.Context, userId, groupId uint) ([]entity.Chat, error) {
+	chats, err := u.chatRepo.GetPinnedChats(ctx, groupId)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.markBlockedSenders(ctx, userId, groupId, chatPointers(chats)...); err != nil {
+		return nil, err
+	}
+	return chats, nil
+}
+
+func (u *chatUsecase) checkAdmin(memberId uint) error {
+	valid, err := u.chatRepo.IsMemberAdmin(memberId)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		return apperror.PermissionDenied("you arent admin")
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"chat_api/entity"
+	"chat_api/pb"
+	"chat_api/utils/apperror"
+	"chat_api/utils/helper"
+	"context"
+	"time"
+)
+
+const defaultMaxReactionsPerChat = 20
+
+func (u *chatUsecase) AddReaction(ctx context.Context, groupId, chatId, memberId uint, emoji string) (*entity.Chat, error) {
+	chat, err := u.getChatInGroup(ctx, groupId, chatId)
+	if err != nil {
+		return nil, err
+	}
+
+	reaction := &entity.Reaction{
+		ChatID:        chatId,
+		GroupMemberID: memberId,
+		Emoji:         emoji,
+	}
+	if err := u.chatRepo.AddReaction(ctx, reaction, u.maxReactionsPerChat); err != nil {
+		return nil, err
+	}
+
+	if chat.Reactions, err = u.chatRepo.GetReactions(ctx, chatId); err != nil {
+		return nil, err
+	}
+	return chat, nil
+}
+
+func (u *chatUsecase) RemoveReaction(ctx context.Context, groupId, chatId, memberId uint, emoji string) (*entity.Chat, error) {
+	chat, err := u.getChatInGroup(ctx, groupId, chatId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.chatRepo.RemoveReaction(ctx, chatId, memberId, emoji); err != nil {
+		return nil, err
+	}
+
+	if chat.Reactions, err = u.chatRepo.GetReactions(ctx, chatId); err != nil {
+		return nil, err
+	}
+	return chat, nil
+}
+
+// ReactionBroadcast sends the new reaction totals of a chat to the group
+func (u *chatUsecase) ReactionBroadcast(chat *entity.Chat, memberId uint) {
+	res := &pb.ChatStreamingResponse{
+		Member:    uint64(memberId),
+		ChatId:    uint64(chat.ID),
+		GroupId:   uint64(chat.GroupID),
+		Timestamp: time.Now().Format(time.RFC3339),
+		Action:    pb.Action_Reaction,
+		Reactions: helper.ConvertReactionsToPb(chat.Reactions),
+	}
+	if chat.ThreadRootID != nil {
+		res.ThreadRootId = uint64(*chat.ThreadRootID)
+	}
+	u.groupBroadcast(chat.GroupID, res)
+}
+
+// getChatInGroup makes sure the chat id from the request belongs to the
+// group the caller is a member of and is not deleted.
+func (u *chatUsecase) getChatInGroup(ctx context.Context, groupId, chatId uint) (*entity.Chat, error) {
+	chat, err := u.findChatInGroup(ctx, groupId, chatId)
+	if err != nil {
+		return nil, err
+	}
+	if chat.DeletedAt.Valid {
+		return nil, apperror.NotFound("chat not found")
+	}
+	return chat, nil
+}
+
+// findChatInGroup is getChatInGroup that also accepts tombstones. Expired
+// chats waiting for the janitor are treated as gone.
+func (u *chatUsecase) findChatInGroup(ctx context.Context, groupId, chatId uint) (*entity.Chat, error) {
+	chat, err := u.chatRepo.GetChat(ctx, chatId)
+	if err != nil {
+		return nil, err
+	}
+	if chat.GroupID != groupId || isExpired(chat) {
+		return nil, apperror.NotFound("chat not found")
+	}
+	return chat, nil
+}
@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"chat_api/entity"
+	"chat_api/utils/apperror"
+	"context"
+)
+
+// GetMessageRevisions is only for the author of the chat and group admins.
+// Revisions of deleted chats stay available to admins for moderation.
+func (u *chatUsecase) GetMessageRevisions(ctx context.Context, userId, groupId, chatId, memberId uint) (*entity.Chat, []entity.ChatRevision, error) {
+	chat, err := u.findChatInGroup(ctx, groupId, chatId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	isAuthor := chat.GroupMemberID != nil && *chat.GroupMemberID == memberId
+	if !isAuthor || chat.DeletedAt.Valid {
+		isAdmin, err := u.chatRepo.IsMemberAdmin(memberId)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !isAdmin && chat.DeletedAt.Valid {
+			return nil, nil, apperror.NotFound("chat not found")
+		}
+		if !isAdmin {
+			return nil, nil, apperror.PermissionDenied("only the sender or an admin can see revisions")
+		}
+	}
+
+	revisions, err := u.chatRepo.GetChatRevisions(ctx, chatId)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := u.markBlockedSenders(ctx, userId, groupId, chat); err != nil {
+		return nil, nil, err
+	}
+	return chat, revisions, nil
+}
@@ -0,0 +1,154 @@
+package usecase
+
+import (
+	"chat_api/entity"
+	"chat_api/utils/apperror"
+	"chat_api/utils/helper"
+	"context"
+	"errors"
+	"log"
+	"time"
+)
+
+const (
+	maxScheduleAhead         = 365 * 24 * time.Hour
+	scheduledBatchSize       = 100
+	defaultSchedulerInterval = 5 * time.Second
+)
+
+func (u *chatUsecase) ScheduleMessage(ctx context.Context, groupId, memberId, userId uint, message string, sendAt time.Time) (*entity.ScheduledMessage, error) {
+	now := time.Now()
+	if !sendAt.After(now) {
+		return nil, apperror.Invalid("send_at must be in the future", apperror.FieldViolation{
+			Field:       "send_at",
+			Description: "value must be in the future",
+		})
+	}
+	if sendAt.After(now.Add(maxScheduleAhead)) {
+		return nil, apperror.Invalid("send_at is too far ahead", apperror.FieldViolation{
+			Field:       "send_at",
+			Description: "value must be within 365 days",
+		})
+	}
+
+	// mention @all dari non-admin ditolak sekarang, bukan saat dikirim
+	if _, err := u.resolveMentions(groupId, memberId, message); err != nil {
+		return nil, err
+	}
+
+	msg := &entity.ScheduledMessage{
+		GroupID: groupId,
+		UserID:  userId,
+		Message: message,
+		SendAt:  sendAt.UTC(),
+		Status:  entity.ScheduledPending,
+	}
+	if err := u.chatRepo.CreateScheduledMessage(ctx, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+func (u *chatUsecase) ListScheduledMessages(ctx context.Context, userId, groupId uint) ([]entity.ScheduledMessage, error) {
+	return u.chatRepo.ListScheduledMessages(ctx, userId, groupId)
+}
+
+func (u *chatUsecase) CancelScheduledMessage(ctx context.Context, id, userId uint) error {
+	return u.chatRepo.CancelScheduledMessage(ctx, id, userId)
+}
+
+// startScheduler sends due scheduled messages every interval
+func (u *chatUsecase) startScheduler(interval time.Duration) {
+	go func() {
+		if err := u.chatRepo.RequeueSendingScheduledMessages(context.Background()); err != nil {
+			log.Printf("failed to requeue scheduled messages: %v", err)
+		}
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for range ticker.C {
+			u.sendDueScheduledMessages()
+		}
+	}()
+}
+
+func (u *chatUsecase) sendDueScheduledMessages() {
+	ctx := context.Background()
+	for {
+		msgs, err := u.chatRepo.GetDueScheduledMessages(ctx, time.Now().UTC(), scheduledBatchSize)
+		if err != nil {
+			log.Printf("failed to load scheduled messages: %v", err)
+			return
+		}
+		for i := range msgs {
+			u.sendScheduledMessage(ctx, &msgs[i])
+		}
+		if len(msgs) < scheduledBatchSize {
+			return
+		}
+	}
+}
+
+func (u *chatUsecase) sendScheduledMessage(ctx context.Context, msg *entity.ScheduledMessage) {
+	claimed, err := u.chatRepo.ClaimScheduledMessage(ctx, msg.ID)
+	if err != nil {
+		log.Printf("failed to claim scheduled message %d: %v", msg.ID, err)
+		return
+	}
+	if !claimed {
+		return
+	}
+
+	status, errMsg := entity.ScheduledSent, ""
+	var chatId *uint
+	chat, err := u.createScheduledChat(ctx, msg)
+	if err != nil {
+		log.Printf("failed to send scheduled message %d: %v", msg.ID, err)
+		status, errMsg = entity.ScheduledFailed, "internal server error"
+		var appErr *apperror.Error
+		if errors.As(err, &appErr) && appErr.Kind != apperror.KindInternal {
+			errMsg = appErr.Message
+		}
+	} else {
+		chatId = &chat.ID
+	}
+
+	if err := u.chatRepo.FinishScheduledMessage(ctx, msg.ID, status, chatId, errMsg); err != nil {
+		log.Printf("failed to update scheduled message %d: %v", msg.ID, err)
+	}
+}
+
+// createScheduledChat goes through the same path as the CreateChat handler,
+// after checking the author is still a member of the group.
+func (u *chatUsecase) createScheduledChat(ctx context.Context, msg *entity.ScheduledMessage) (*entity.Chat, error) {
+	ismember, memberId, err := u.chatRepo.GetGroupMemberID(ctx, msg.UserID, msg.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	if !ismember {
+		return nil, apperror.PermissionDenied("author is no longer a member of the group")
+	}
+
+	statuses, err := u.GetMemberStatuses(msg.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	var anyStatusUser []helper.StatusUser
+	for _, s := range statuses {
+		anyStatusUser = append(anyStatusUser, helper.StatusUser{MemberId: s.MemberID, Status: s.Status})
+	}
+
+	chat, err := u.CreateChat(ctx, &helper.CreateChatReq{
+		MemberId:      memberId,
+		Message:       msg.Message,
+		GroupId:       msg.GroupID,
+		AnyStatusUser: anyStatusUser,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	u.ChatBroadcast(chat, 0)
+	u.ThreadBroadcast(ctx, chat)
+	return chat, nil
+}
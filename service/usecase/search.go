@@ -0,0 +1,175 @@
+package usecase
+
+import (
+	"chat_api/entity"
+	"chat_api/utils/apperror"
+	"chat_api/utils/helper"
+	"chat_api/utils/search"
+	"context"
+	"errors"
+	"log"
+	"time"
+)
+
+const (
+	defaultSearchLimit = 20
+	indexBatchSize     = 500
+)
+
+func (u *chatUsecase) SearchMessages(ctx context.Context, req *helper.SearchMessagesReq) ([]helper.SearchHit, int, error) {
+	if !req.From.IsZero() && !req.To.IsZero() && !req.From.Before(req.To) {
+		return nil, 0, apperror.Invalid("invalid date range", apperror.FieldViolation{
+			Field:       "to",
+			Description: "value must be after from",
+		})
+	}
+
+	groupIds := []uint{req.GroupID}
+	if req.GroupID == 0 {
+		var err error
+		if groupIds, err = u.chatRepo.GetUserGroupIDs(ctx, req.UserID); err != nil {
+			return nil, 0, err
+		}
+		if len(groupIds) == 0 {
+			return nil, 0, nil
+		}
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultSearchLimit
+	}
+
+	result, err := u.searchIndex.Search(search.Query{
+		Text:          req.Query,
+		GroupIDs:      groupIds,
+		AuthorID:      req.AuthorID,
+		From:          req.From,
+		To:            req.To,
+		HasAttachment: req.HasAttachment,
+		Now:           time.Now(),
+		Limit:         limit,
+		Offset:        req.Offset,
+	})
+	if errors.Is(err, search.ErrEmptyQuery) {
+		return nil, 0, apperror.Invalid("query has no searchable words", apperror.FieldViolation{
+			Field:       "query",
+			Description: "value must contain at least one letter or digit",
+		})
+	}
+	if err != nil {
+		return nil, 0, apperror.Wrap(apperror.KindInternal, "internal server error", err)
+	}
+
+	ids := make([]uint, len(result.Hits))
+	for i, h := range result.Hits {
+		ids[i] = h.ID
+	}
+	chats, err := u.chatRepo.GetChatsByIDs(ctx, ids)
+	if err != nil {
+		return nil, 0, err
+	}
+	byId := make(map[uint]*entity.Chat, len(chats))
+	for i := range chats {
+		byId[chats[i].ID] = &chats[i]
+	}
+
+	total := result.Total
+	var hits []helper.SearchHit
+	for _, h := range result.Hits {
+		chat, ok := byId[h.ID]
+		if !ok {
+			// sudah dihapus tapi masih ada di index, jangan dihitung lagi
+			u.unindexChat(h.ID)
+			total--
+			continue
+		}
+		hits = append(hits, helper.SearchHit{
+			Chat:       chat,
+			Snippet:    h.Snippet,
+			Highlights: h.Highlights,
+			Score:      h.Score,
+		})
+	}
+	if err := u.markBlockedSenders(ctx, req.UserID, req.GroupID, chatPointers(chats)...); err != nil {
+		return nil, 0, err
+	}
+	return hits, total, nil
+}
+
+// indexChat keeps the search index in sync after a chat is created or edited
+func (u *chatUsecase) indexChat(chat *entity.Chat) {
+	u.indexMu.Lock()
+	defer u.indexMu.Unlock()
+	u.touchIndex(chat.ID)
+	u.indexDocument(chat)
+}
+
+func (u *chatUsecase) unindexChat(chatId uint) {
+	u.indexMu.Lock()
+	defer u.indexMu.Unlock()
+	u.touchIndex(chatId)
+	if err := u.searchIndex.Delete(chatId); err != nil {
+		log.Printf("failed to remove chat %d from search index: %v", chatId, err)
+	}
+}
+
+// touchIndex remembers chats changed while the index is rebuilt, so the
+// rebuild does not overwrite them with the older copy it loaded. indexMu must
+// be held.
+func (u *chatUsecase) touchIndex(chatId uint) {
+	if u.indexTouched != nil {
+		u.indexTouched[chatId] = true
+	}
+}
+
+func (u *chatUsecase) indexDocument(chat *entity.Chat) {
+	doc := search.Document{
+		ID:            chat.ID,
+		GroupID:       chat.GroupID,
+		Text:          chat.Message,
+		HasAttachment: len(chat.Attachments) > 0,
+		CreatedAt:     chat.CreatedAt,
+		ExpiresAt:     chat.ExpiresAt,
+	}
+	if chat.GroupMember != nil {
+		doc.AuthorID = chat.GroupMember.UserID
+	}
+	if err := u.searchIndex.Index(doc); err != nil {
+		log.Printf("failed to index chat %d: %v", chat.ID, err)
+	}
+}
+
+// rebuildSearchIndex loads every chat into the index, dipanggil saat startup.
+// indexTouched harus sudah diisi sebelum goroutine ini jalan
+func (u *chatUsecase) rebuildSearchIndex() {
+	defer func() {
+		u.indexMu.Lock()
+		u.indexTouched = nil
+		u.indexMu.Unlock()
+	}()
+
+	ctx := context.Background()
+	var lastId uint
+	total := 0
+	for {
+		chats, err := u.chatRepo.GetChatsForIndex(ctx, lastId, indexBatchSize)
+		if err != nil {
+			log.Printf("failed to build search index: %v", err)
+			return
+		}
+		u.indexMu.Lock()
+		for i := range chats {
+			if !u.indexTouched[chats[i].ID] {
+				u.indexDocument(&chats[i])
+			}
+		}
+		u.indexMu.Unlock()
+		total += len(chats)
+		if len(chats) < indexBatchSize {
+			break
+		}
+		lastId = chats[len(chats)-1].ID
+	}
+	log.Printf("search index ready, %d chats indexed", total)
+}
@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"chat_api/entity"
+	"chat_api/pb"
+	"context"
+	"log"
+)
+
+// GetThread returns the root and replies of the thread chatId belongs to;
+// chatId may be the root itself or any reply. Deleted chats are included as
+// tombstones.
+func (u *chatUsecase) GetThread(ctx context.Context, userId, groupId, chatId uint) (*entity.Chat, []entity.Chat, error) {
+	root, err := u.findChatInGroup(ctx, groupId, chatId)
+	if err != nil {
+		return nil, nil, err
+	}
+	if root.ThreadRootID != nil {
+		if root, err = u.findChatInGroup(ctx, groupId, *root.ThreadRootID); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	replies, err := u.chatRepo.GetThreadReplies(ctx, root.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := u.markBlockedSenders(ctx, userId, groupId, append(chatPointers(replies), root)...); err != nil {
+		return nil, nil, err
+	}
+	return root, replies, nil
+}
+
+// ThreadBroadcast sends the updated reply count of the thread root after a
+// reply was created or deleted.
+func (u *chatUsecase) ThreadBroadcast(ctx context.Context, chat *entity.Chat) {
+	if chat.ThreadRootID == nil {
+		return
+	}
+
+	root, err := u.chatRepo.GetChat(ctx, *chat.ThreadRootID)
+	if err != nil {
+		log.Printf("failed to load thread root %d: %v", *chat.ThreadRootID, err)
+		return
+	}
+	u.ChatBroadcast(root, int(pb.Action_ThreadUpdate))
+}
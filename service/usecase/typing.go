@@ -0,0 +1,167 @@
+package usecase
+
+import (
+	"chat_api/pb"
+	"chat_api/utils/apperror"
+	"context"
+	"log"
+	"time"
+)
+
+const (
+	defaultTypingTimeout     = 5 * time.Second
+	defaultTypingMinInterval = time.Second
+
+	// batas ukuran map sebelum entri lama dibersihkan
+	typingPruneThreshold = 1024
+)
+
+// typingState only lives in memory; it is removed when the member stops
+// typing, sends a chat or does not refresh within typingTimeout.
+type typingState struct {
+	groupId  uint
+	memberId uint
+	username string
+	timer    *time.Timer
+}
+
+// SetTyping starts or refreshes (typing=true) or stops the typing indicator
+// of a member. Only the start and the stop are broadcast.
+func (u *chatUsecase) SetTyping(ctx context.Context, groupId, memberId uint, typing bool) error {
+	if !typing {
+		u.stopTyping(memberId)
+		return nil
+	}
+
+	if err := u.allowTyping(memberId); err != nil {
+		return err
+	}
+
+	u.typingMu.Lock()
+	if state, ok := u.typing[memberId]; ok {
+		state.timer.Reset(u.typingTimeout)
+		u.typingMu.Unlock()
+		return nil
+	}
+	u.typingMu.Unlock()
+
+	username, err := u.memberUsername(groupId, memberId)
+	if err != nil {
+		return err
+	}
+
+	u.typingMu.Lock()
+	if state, ok := u.typing[memberId]; ok {
+		state.timer.Reset(u.typingTimeout)
+		u.typingMu.Unlock()
+		return nil
+	}
+	state := &typingState{
+		groupId:  groupId,
+		memberId: memberId,
+		username: username,
+	}
+	state.timer = time.AfterFunc(u.typingTimeout, func() { u.expireTyping(state) })
+	u.typing[memberId] = state
+	u.typingMu.Unlock()
+
+	u.typingBroadcast(state, true)
+	return nil
+}
+
+// allowTyping limits typing=true calls to one per typingMinInterval per member
+func (u *chatUsecase) allowTyping(memberId uint) error {
+	now := time.Now()
+
+	u.typingMu.Lock()
+	defer u.typingMu.Unlock()
+
+	if last, ok := u.typingLastCall[memberId]; ok && now.Sub(last) < u.typingMinInterval {
+		return apperror.RateLimited("typing updates too frequent")
+	}
+
+	if len(u.typingLastCall) >= typingPruneThreshold {
+		for id, last := range u.typingLastCall {
+			if now.Sub(last) >= u.typingMinInterval {
+				delete(u.typingLastCall, id)
+			}
+		}
+	}
+	u.typingLastCall[memberId] = now
+	return nil
+}
+
+func (u *chatUsecase) stopTyping(memberId uint) {
+	u.typingMu.Lock()
+	state, ok := u.typing[memberId]
+	if ok {
+		state.timer.Stop()
+		delete(u.typing, memberId)
+	}
+	u.typingMu.Unlock()
+
+	if ok {
+		u.typingBroadcast(state, false)
+	}
+}
+
+func (u *chatUsecase) expireTyping(state *typingState) {
+	u.typingMu.Lock()
+	// sudah dihentikan atau diganti state baru
+	if u.typing[state.memberId] != state {
+		u.typingMu.Unlock()
+		return
+	}
+	delete(u.typing, state.memberId)
+	u.typingMu.Unlock()
+
+	u.typingBroadcast(state, false)
+}
+
+func (u *chatUsecase) memberUsername(groupId, memberId uint) (string, error) {
+	members, err := u.chatRepo.GetMemberGroup(groupId)
+	if err != nil {
+		return "", err
+	}
+	for _, m := range members {
+		if m.ID == memberId {
+			return m.Username, nil
+		}
+	}
+	return "", apperror.NotFound("member not found")
+}
+
+// typingBroadcast sends the typing state to both the chat and the status
+// streams of the group.
+func (u *chatUsecase) typingBroadcast(state *typingState, typing bool) {
+	u.groupBroadcast(state.groupId, &pb.ChatStreamingResponse{
+		Member:    uint64(state.memberId),
+		Username:  state.username,
+		GroupId:   uint64(state.groupId),
+		Timestamp: time.Now().Format(time.RFC3339),
+		Action:    pb.Action_Typing,
+		Typing:    typing,
+	})
+
+	u.statusBroadcast(state.groupId, &pb.StatusStreamingResponse{
+		Member:   uint64(state.memberId),
+		Username: state.username,
+		Event:    pb.StatusEvent_TypingChanged,
+		Typing:   typing,
+	})
+}
+
+// statusBroadcast sends an event to every status stream subscribed to the group
+func (u *chatUsecase) statusBroadcast(groupId uint, res *pb.StatusStreamingResponse) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	for clientId, client := range u.streamStatusOnGroup {
+		if client.GroupId != groupId {
+			continue
+		}
+		if err := client.Stream.Send(res); err != nil {
+			log.Printf("error sending status to client %s: %v, removing client", clientId, err)
+			delete(u.streamStatusOnGroup, clientId)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package usecase
+
+import (
+	"chat_api/entity"
+	"chat_api/pb"
+	"chat_api/service/repository"
+	"chat_api/utils/apperror"
+	"chat_api/utils/helper"
+	"chat_api/utils/media"
+	"context"
+	"log"
+	"strings"
+	"time"
+)
+
+const defaultUserSearchLimit = 20
+
+type UserUsecase interface {
+	GetMe(ctx context.Context, userId uint) (*entity.User, error)
+	UpdateProfile(ctx context.Context, userId uint, req *helper.UpdateProfileReq) (*entity.User, error)
+	GetUser(ctx context.Context, userId uint) (*entity.User, error)
+	GetUsers(ctx context.Context, userIds []uint) ([]entity.User, error)
+	SearchUsers(ctx context.Context, callerId uint, query string, limit, offset int) ([]entity.User, int64, error)
+
+	//block
+	BlockUser(ctx context.Context, userId, blockedUserId uint) (*entity.UserBlock, error)
+	UnblockUser(ctx context.Context, userId, blockedUserId uint) error
+	ListBlockedUsers(ctx context.Context, userId uint) ([]entity.UserBlock, error)
+}
+
+// ProfileNotifier tells the groups of a user that the profile changed,
+// implemented by the chat usecase
+type ProfileNotifier interface {
+	ProfileBroadcast(ctx context.Context, user *entity.User)
+}
+
+type userUsecase struct {
+	userRepo repository.UserRepository
+	notifier ProfileNotifier
+}
+
+func NewUserUsecase(userRepo repository.UserRepository, notifier ProfileNotifier) UserUsecase {
+	return &userUsecase{userRepo, notifier}
+}
+
+func (u *userUsecase) GetMe(ctx context.Context, userId uint) (*entity.User, error) {
+	return u.userRepo.GetUser(ctx, userId)
+}
+
+func (u *userUsecase) UpdateProfile(ctx context.Context, userId uint, req *helper.UpdateProfileReq) (*entity.User, error) {
+	user, err := u.userRepo.UpdateProfile(ctx, userId, req)
+	if err != nil {
+		return nil, err
+	}
+	u.notifier.ProfileBroadcast(ctx, user)
+	return user, nil
+}
+
+func (u *userUsecase) GetUser(ctx context.Context, userId uint) (*entity.User, error) {
+	return u.userRepo.GetUser(ctx, userId)
+}
+
+func (u *userUsecase) GetUsers(ctx context.Context, userIds []uint) ([]entity.User, error) {
+	return u.userRepo.GetUsers(ctx, userIds)
+}
+
+func (u *userUsecase) SearchUsers(ctx context.Context, callerId uint, query string, limit, offset int) ([]entity.User, int64, error) {
+	if limit <= 0 {
+		limit = defaultUserSearchLimit
+	}
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, 0, apperror.Invalid("query is empty", apperror.FieldViolation{
+			Field:       "query",
+			Description: "query must contain non-space characters",
+		})
+	}
+	return u.userRepo.SearchUsers(ctx, callerId, query, limit, offset)
+}
+
+// ProfileBroadcast sends the new profile to the chat and status streams of
+// every group the user is in, so clients can refresh cached usernames
+func (u *chatUsecase) ProfileBroadcast(ctx context.Context, user *entity.User) {
+	members, err := u.chatRepo.GetUserMemberships(ctx, user.ID)
+	if err != nil {
+		log.Printf("failed to load groups of user %d: %v", user.ID, err)
+		return
+	}
+
+	profile := helper.ConvertUserToPb(user)
+	for _, m := range members {
+		u.groupBroadcast(m.GroupID, &pb.ChatStreamingResponse{
+			Member:    uint64(m.ID),
+			Username:  user.Username,
+			GroupId:   uint64(m.GroupID),
+			Timestamp: time.Now().Format(time.RFC3339),
+			Action:    pb.Action_ProfileUpdate,
+			Profile:   profile,
+		})
+		u.statusBroadcast(m.GroupID, &pb.StatusStreamingResponse{
+			Member:   uint64(m.ID),
+			Username: user.Username,
+			Event:    pb.StatusEvent_ProfileUpdated,
+			Profile:  profile,
+		})
+	}
+}
+
+// IsAvatarAttachment reports whether the attachment is a processed image used
+// as someone's avatar, which every user may download
+func (u *chatUsecase) IsAvatarAttachment(ctx context.Context, attachment *entity.Attachment) (bool, error) {
+	if attachment.Status != entity.AttachmentReady || !media.IsImage(attachment.ContentType) {
+		return false, nil
+	}
+	return u.chatRepo.IsAvatarAttachment(ctx, attachment.ID)
+}
@@ -0,0 +1,139 @@
+package apperror
+
+import (
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+type Kind int
+
+const (
+	KindInternal Kind = iota
+	KindNotFound
+	KindPermissionDenied
+	KindAlreadyExists
+	KindInvalid
+	KindConflict
+	KindUnauthenticated
+	KindRateLimited
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindNotFound:
+		return "NOT_FOUND"
+	case KindPermissionDenied:
+		return "PERMISSION_DENIED"
+	case KindAlreadyExists:
+		return "ALREADY_EXISTS"
+	case KindInvalid:
+		return "INVALID"
+	case KindConflict:
+		return "CONFLICT"
+	case KindUnauthenticated:
+		return "UNAUTHENTICATED"
+	case KindRateLimited:
+		return "RATE_LIMITED"
+	default:
+		return "INTERNAL"
+	}
+}
+
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// Error is a domain error returned by repositories and usecases. Message is
+// safe to show to clients; Err is the underlying cause and is only logged.
+type Error struct {
+	Kind       Kind
+	Message    string
+	Violations []FieldViolation
+	Err        error
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%s: %v", e.Message, e.Err)
+	}
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+func New(kind Kind, msg string) error {
+	return &Error{Kind: kind, Message: msg}
+}
+
+func Wrap(kind Kind, msg string, err error) error {
+	return &Error{Kind: kind, Message: msg, Err: err}
+}
+
+func NotFound(msg string) error {
+	return New(KindNotFound, msg)
+}
+
+func PermissionDenied(msg string) error {
+	return New(KindPermissionDenied, msg)
+}
+
+func AlreadyExists(msg string) error {
+	return New(KindAlreadyExists, msg)
+}
+
+func Invalid(msg string, violations ...FieldViolation) error {
+	return &Error{Kind: KindInvalid, Message: msg, Violations: violations}
+}
+
+func Conflict(msg string) error {
+	return New(KindConflict, msg)
+}
+
+func Unauthenticated(msg string) error {
+	return New(KindUnauthenticated, msg)
+}
+
+func RateLimited(msg string) error {
+	return New(KindRateLimited, msg)
+}
+
+// FromDB translates a gorm error into a domain error. notFound is the client
+// message used when the record does not exist.
+func FromDB(err error, notFound string) error {
+	if err == nil {
+		return nil
+	}
+
+	var appErr *Error
+	if errors.As(err, &appErr) {
+		return err
+	}
+
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return Wrap(KindNotFound, notFound, err)
+	case errors.Is(err, gorm.ErrDuplicatedKey):
+		return Wrap(KindAlreadyExists, "record already exists", err)
+	case errors.Is(err, gorm.ErrForeignKeyViolated):
+		return Wrap(KindInvalid, "referenced record does not exist", err)
+	default:
+		return Wrap(KindInternal, "internal server error", err)
+	}
+}
+
+func KindOf(err error) Kind {
+	var appErr *Error
+	if errors.As(err, &appErr) {
+		return appErr.Kind
+	}
+	return KindInternal
+}
+
+func IsNotFound(err error) bool {
+	return err != nil && KindOf(err) == KindNotFound
+}
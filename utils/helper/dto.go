@@ -3,6 +3,7 @@ package helper
 import (
 	"chat_api/entity"
 	"chat_api/pb"
+	"time"
 )
 
 // parsing data
@@ -29,6 +30,9 @@ type CreateChatReq struct {
 	Message       string
 	GroupId       uint
 	AnyStatusUser []StatusUser
+	AttachmentIDs []uint
+	ReplyToID     uint
+	MentionIDs    []uint
 }
 
 // struct for parsing pb to create chat
@@ -46,11 +50,18 @@ func ParsingPbToCreateChat(req *pb.CreateChatRequest, memberId uint) *CreateChat
 			Status:   s.Status,
 		})
 	}
+	var attachmentIds []uint
+	for _, id := range req.AttachmentIds {
+		attachmentIds = append(attachmentIds, uint(id))
+	}
+
 	return &CreateChatReq{
 		MemberId:      memberId,
 		Message:       req.Message,
 		GroupId:       uint(req.GroupId),
 		AnyStatusUser: anyUserStatus,
+		AttachmentIDs: attachmentIds,
+		ReplyToID:     uint(req.ReplyToId),
 	}
 }
 
@@ -100,13 +111,162 @@ func ConvertChatToPbResponse(chat *entity.Chat) []*pb.AnyUserStatus {
 	return readStatus
 }
 
+// ConvertChatToPbStream builds the stream event for a chat. The sender may be
+// nil when the member has left the group (GroupMemberID SET NULL).
+func ConvertChatToPbStream(chat *entity.Chat, action pb.Action) *pb.ChatStreamingResponse {
+	res := &pb.ChatStreamingResponse{
+		ChatId:     uint64(chat.ID),
+		GroupId:    uint64(chat.GroupID),
+		Message:    chat.Message,
+		Timestamp:  chat.CreatedAt.Format(time.RFC3339),
+		Action:     action,
+		ReadStatus: ConvertChatToPbResponse(chat),
+	}
+	if chat.GroupMemberID != nil {
+		res.Member = uint64(*chat.GroupMemberID)
+	}
+	if chat.GroupMember != nil {
+		res.Username = chat.GroupMember.User.Username
+	}
+	for i := range chat.Attachments {
+		res.Attachments = append(res.Attachments, ConvertAttachmentToPb(&chat.Attachments[i]))
+	}
+	res.Reactions = ConvertReactionsToPb(chat.Reactions)
+
+	if chat.ReplyTo != nil {
+		res.ReplyTo = ConvertChatToReplyPreview(chat.ReplyTo)
+	}
+	if chat.ThreadRootID != nil {
+		res.ThreadRootId = uint64(*chat.ThreadRootID)
+	}
+	res.ThreadReplyCount = int32(chat.ThreadReplyCount)
+	for _, m := range chat.Mentions {
+		res.Mentions = append(res.Mentions, uint64(m.GroupMemberID))
+	}
+	if chat.LastReplyAt != nil {
+		res.LastReplyAt = chat.LastReplyAt.Format(time.RFC3339)
+	}
+	if chat.Pin != nil {
+		res.Pinned = true
+		res.PinnedAt = chat.Pin.CreatedAt.Format(time.RFC3339)
+		if chat.Pin.GroupMemberID != nil {
+			res.PinnedBy = uint64(*chat.Pin.GroupMemberID)
+		}
+	}
+
+	if chat.EditedAt != nil {
+		res.EditedAt = chat.EditedAt.Format(time.RFC3339)
+	}
+	res.SenderBlocked = chat.SenderBlocked
+	if chat.ExpiresAt != nil {
+		res.ExpiresAt = chat.ExpiresAt.Format(time.RFC3339)
+	}
+	if chat.ForwardedFromChatID != nil {
+		res.ForwardedFrom = &pb.ForwardInfo{
+			ChatId:    uint64(*chat.ForwardedFromChatID),
+			GroupName: chat.ForwardedFromGroupName,
+			Username:  chat.ForwardedFromUsername,
+		}
+		if chat.ForwardedFromGroupID != nil {
+			res.ForwardedFrom.GroupId = uint64(*chat.ForwardedFromGroupID)
+		}
+		if chat.ForwardedFromUserID != nil {
+			res.ForwardedFrom.UserId = uint64(*chat.ForwardedFromUserID)
+		}
+	}
+
+	// tombstone, isi chat yang dihapus tidak dikirim lagi
+	if chat.DeletedAt.Valid {
+		res.Message = ""
+		res.Attachments = nil
+		res.Reactions = nil
+		res.Mentions = nil
+		res.Deleted = true
+		res.DeletedAt = chat.DeletedAt.Time.Format(time.RFC3339)
+		if chat.DeletedByID != nil {
+			res.DeletedBy = uint64(*chat.DeletedByID)
+		}
+	}
+	return res
+}
+
+const snippetLength = 100
+
+// ConvertChatToReplyPreview quotes the replied chat, cut to snippetLength runes
+func ConvertChatToReplyPreview(chat *entity.Chat) *pb.ReplyPreview {
+	preview := &pb.ReplyPreview{
+		ChatId:  uint64(chat.ID),
+		Snippet: chat.Message,
+	}
+	if runes := []rune(chat.Message); len(runes) > snippetLength {
+		preview.Snippet = string(runes[:snippetLength]) + "…"
+	}
+	if chat.DeletedAt.Valid {
+		preview.Snippet = ""
+		preview.Deleted = true
+	}
+	if chat.GroupMemberID != nil {
+		preview.Member = uint64(*chat.GroupMemberID)
+	}
+	if chat.GroupMember != nil {
+		preview.Username = chat.GroupMember.User.Username
+	}
+	return preview
+}
+
+// ConvertReactionsToPb aggregates reactions per emoji, in the order each
+// emoji was first used.
+func ConvertReactionsToPb(reactions []entity.Reaction) []*pb.ReactionCount {
+	var result []*pb.ReactionCount
+	byEmoji := make(map[string]*pb.ReactionCount)
+	for _, r := range reactions {
+		count, ok := byEmoji[r.Emoji]
+		if !ok {
+			count = &pb.ReactionCount{Emoji: r.Emoji}
+			byEmoji[r.Emoji] = count
+			result = append(result, count)
+		}
+		count.Count++
+		count.MemberIds = append(count.MemberIds, uint64(r.GroupMemberID))
+	}
+	return result
+}
+
+func ConvertAttachmentToPb(attachment *entity.Attachment) *pb.Attachment {
+	var thumbnails []*pb.Thumbnail
+	for _, t := range attachment.Thumbnails {
+		thumbnails = append(thumbnails, &pb.Thumbnail{
+			Size:   int32(t.Size),
+			Width:  int32(t.Width),
+			Height: int32(t.Height),
+		})
+	}
+
+	return &pb.Attachment{
+		Id:          uint64(attachment.ID),
+		FileName:    attachment.FileName,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		Sha256:      attachment.Checksum,
+		Width:       int32(attachment.Width),
+		Height:      int32(attachment.Height),
+		Blurhash:    attachment.Blurhash,
+		Status:      attachment.Status,
+		Thumbnails:  thumbnails,
+	}
+}
+
 func ParsingDtoGroupToPB(req []GroupInfo) []*pb.GroupInfo {
 	var response []*pb.GroupInfo
 	for _, group := range req {
 		response = append(response, &pb.GroupInfo{
-			Id:          uint64(group.GroupID),
-			Name:        group.Name,
-			LastMessage: group.LastMessage,
+			Id:                 uint64(group.GroupID),
+			Name:               group.Name,
+			LastMessage:        group.LastMessage,
+			UnreadCount:        int32(group.UnreadCount),
+			UnreadMentionCount: int32(group.UnreadMentionCount),
+			MessageTtlSeconds:  group.MessageTTLSeconds,
+			Visibility:         group.Visibility,
 		})
 	}
 
@@ -121,9 +281,139 @@ type MemberChat struct {
 
 // dto
 type GroupInfo struct {
-	GroupMemberID uint   `json:"group_member_id"`
-	GroupID       uint   `json:"group_id"`
-	Name          string `json:"name"`
-	LastMessage   string `json:"last_message"`
-	UnreadCount   int    `json:"unread_count"`
+	GroupMemberID      uint   `json:"group_member_id"`
+	GroupID            uint   `json:"group_id"`
+	Name               string `json:"name"`
+	LastMessage        string `json:"last_message"`
+	UnreadCount        int    `json:"unread_count"`
+	UnreadMentionCount int    `json:"unread_mention_count"`
+	MessageTTLSeconds  int64  `json:"message_ttl_seconds"`
+	Visibility         string `json:"visibility"`
+}
+
+// dto
+type GroupPreview struct {
+	GroupID     uint   `json:"group_id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Visibility  string `json:"visibility"`
+	MemberCount int    `json:"member_count"`
+	IsMember    bool   `json:"is_member"`
+}
+
+// dto
+type AttachmentInfo struct {
+	MemberId    uint
+	GroupId     uint
+	FileName    string
+	ContentType string
+	Size        int64
+	Checksum    string
+}
+
+func ParsingPbToAttachmentInfo(req *pb.AttachmentInfo, memberId uint) *AttachmentInfo {
+	return &AttachmentInfo{
+		MemberId:    memberId,
+		GroupId:     uint(req.GroupId),
+		FileName:    req.FileName,
+		ContentType: req.ContentType,
+		Size:        req.Size,
+		Checksum:    req.Sha256,
+	}
+}
+
+func ConvertMentionsToPb(mentions []entity.Mention) []*pb.MentionInfo {
+	var response []*pb.MentionInfo
+	for _, m := range mentions {
+		info := &pb.MentionInfo{
+			Id:        uint64(m.ID),
+			IsRead:    m.IsRead,
+			CreatedAt: m.CreatedAt.Format(time.RFC3339),
+		}
+		if m.Chat != nil {
+			info.GroupId = uint64(m.Chat.GroupID)
+			info.Chat = ConvertChatToPbStream(m.Chat, pb.Action_Create)
+		}
+		response = append(response, info)
+	}
+	return response
+}
+
+func ConvertRevisionsToPb(revisions []entity.ChatRevision) []*pb.MessageRevision {
+	var result []*pb.MessageRevision
+	for _, r := range revisions {
+		revision := &pb.MessageRevision{
+			Id:       uint64(r.ID),
+			Message:  r.Message,
+			EditedAt: r.CreatedAt.Format(time.RFC3339),
+		}
+		if r.GroupMemberID != nil {
+			revision.EditedBy = uint64(*r.GroupMemberID)
+		}
+		if r.GroupMember != nil {
+			revision.EditorUsername = r.GroupMember.User.Username
+		}
+		result = append(result, revision)
+	}
+	return result
+}
+
+func ConvertScheduledMessageToPb(msg *entity.ScheduledMessage) *pb.ScheduledMessage {
+	return &pb.ScheduledMessage{
+		Id:        uint64(msg.ID),
+		GroupId:   uint64(msg.GroupID),
+		Message:   msg.Message,
+		SendAt:    msg.SendAt.Format(time.RFC3339),
+		Status:    msg.Status,
+		Error:     msg.Error,
+		CreatedAt: msg.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func ConvertInviteToPb(invite *entity.GroupInvite) *pb.Invite {
+	res := &pb.Invite{
+		Code:      invite.Code,
+		GroupId:   uint64(invite.GroupID),
+		Role:      invite.Role,
+		MaxUses:   int32(invite.MaxUses),
+		Uses:      int32(invite.Uses),
+		CreatedAt: invite.CreatedAt.Format(time.RFC3339),
+	}
+	if invite.ExpiresAt != nil {
+		res.ExpiresAt = invite.ExpiresAt.Format(time.RFC3339)
+	}
+	if invite.GroupMemberID != nil {
+		res.CreatedBy = uint64(*invite.GroupMemberID)
+	}
+	return res
+}
+
+func ConvertJoinRequestToPb(req *entity.JoinRequest) *pb.JoinRequest {
+	res := &pb.JoinRequest{
+		Id:        uint64(req.ID),
+		GroupId:   uint64(req.GroupID),
+		UserId:    uint64(req.UserID),
+		Username:  req.User.Username,
+		Note:      req.Note,
+		Status:    req.Status,
+		CreatedAt: req.CreatedAt.Format(time.RFC3339),
+	}
+	if req.GroupMemberID != nil {
+		res.ReviewedBy = uint64(*req.GroupMemberID)
+	}
+	if req.ReviewedAt != nil {
+		res.ReviewedAt = req.ReviewedAt.Format(time.RFC3339)
+	}
+	return res
+}
+
+func ConvertGroupPreviewToPb(group *GroupPreview) *pb.GroupPreview {
+	return &pb.GroupPreview{
+		Id:          uint64(group.GroupID),
+		Name:        group.Name,
+		Description: group.Description,
+		Visibility:  group.Visibility,
+		MemberCount: int32(group.MemberCount),
+		IsMember:    group.IsMember,
+	}
 }
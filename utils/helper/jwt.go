@@ -2,7 +2,6 @@ package helper
 
 import (
 	"errors"
-	"fmt"
 	"os"
 	"time"
 
@@ -28,7 +27,6 @@ func GenerateJWTLogin(userid uint, email string) (string, error) {
 		},
 	}
 
-	fmt.Println(jwt_secret)
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	return token.SignedString(jwt_secret)
 }
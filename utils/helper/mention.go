@@ -0,0 +1,71 @@
+package helper
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const MentionAll = "all"
+
+// ParseMentions finds "@username" mentions of group members in message.
+// Usernames may contain any character, so every "@" is matched against the
+// member list (longest username first) instead of a fixed token pattern.
+// all is true when the message contains "@all".
+func ParseMentions(message string, members []MemberChat) (memberIds []uint, all bool) {
+	sorted := make([]MemberChat, len(members))
+	copy(sorted, members)
+	sort.Slice(sorted, func(i, j int) bool {
+		return len(sorted[i].Username) > len(sorted[j].Username)
+	})
+
+	seen := make(map[uint]bool)
+	for i := 0; i < len(message); i++ {
+		if message[i] != '@' || !mentionBoundaryBefore(message, i) {
+			continue
+		}
+		rest := message[i+1:]
+
+		if hasMentionPrefix(rest, MentionAll) {
+			all = true
+			continue
+		}
+
+		for _, m := range sorted {
+			if m.Username == "" || !hasMentionPrefix(rest, m.Username) {
+				continue
+			}
+			if !seen[m.ID] {
+				seen[m.ID] = true
+				memberIds = append(memberIds, m.ID)
+			}
+			break
+		}
+	}
+	return memberIds, all
+}
+
+// hasMentionPrefix matches name case-insensitively at the start of s, and
+// only when it is not followed by another letter or digit ("@bobby" is not
+// "@bob").
+func hasMentionPrefix(s, name string) bool {
+	if len(s) < len(name) || !strings.EqualFold(s[:len(name)], name) {
+		return false
+	}
+	next, _ := utf8.DecodeRuneInString(s[len(name):])
+	return next == utf8.RuneError || !isMentionRune(next)
+}
+
+// "@" di tengah kata (misal email) bukan mention
+func mentionBoundaryBefore(s string, i int) bool {
+	if i == 0 {
+		return true
+	}
+	prev, _ := utf8.DecodeLastRuneInString(s[:i])
+	return !isMentionRune(prev)
+}
+
+func isMentionRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
@@ -0,0 +1,49 @@
+package helper
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseMentions(t *testing.T) {
+	members := []MemberChat{
+		{ID: 1, Username: "bob"},
+		{ID: 2, Username: "bob.smith"},
+		{ID: 3, Username: "Alice"},
+		{ID: 4, Username: "budi santoso"},
+	}
+
+	tests := []struct {
+		name    string
+		message string
+		want    []uint
+		wantAll bool
+	}{
+		{name: "no mention", message: "halo semua"},
+		{name: "single", message: "hi @bob", want: []uint{1}},
+		{name: "case insensitive", message: "@alice tolong cek", want: []uint{3}},
+		{name: "longest username wins", message: "cc @bob.smith", want: []uint{2}},
+		{name: "trailing punctuation", message: "thanks @bob!", want: []uint{1}},
+		{name: "username with space", message: "@budi santoso sudah?", want: []uint{4}},
+		{name: "longer word is not a mention", message: "@bobby hi"},
+		{name: "email is not a mention", message: "mail me at bob@bob.smith"},
+		{name: "duplicates once in order", message: "@Alice @bob @alice", want: []uint{3, 1}},
+		{name: "all", message: "@all meeting", wantAll: true},
+		{name: "all with member", message: "@all and @bob", want: []uint{1}, wantAll: true},
+		{name: "allison is not all", message: "@allison"},
+		{name: "unknown user", message: "@carol"},
+		{name: "lone at", message: "@ @"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, all := ParseMentions(tt.message, members)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseMentions(%q) ids = %v, want %v", tt.message, got, tt.want)
+			}
+			if all != tt.wantAll {
+				t.Errorf("ParseMentions(%q) all = %v, want %v", tt.message, all, tt.wantAll)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package helper
+
+import (
+	"chat_api/entity"
+	"chat_api/pb"
+	"chat_api/utils/apperror"
+	"chat_api/utils/search"
+	"time"
+)
+
+type SearchMessagesReq struct {
+	UserID        uint
+	GroupID       uint
+	AuthorID      uint
+	Query         string
+	From, To      time.Time
+	HasAttachment *bool
+	Limit, Offset int
+}
+
+type SearchHit struct {
+	Chat       *entity.Chat
+	Snippet    string
+	Highlights []search.Highlight
+	Score      float64
+}
+
+func ParsingPbToSearchMessages(req *pb.SearchMessagesRequest, userId uint) (*SearchMessagesReq, error) {
+	res := &SearchMessagesReq{
+		UserID:        userId,
+		GroupID:       uint(req.GroupId),
+		AuthorID:      uint(req.AuthorId),
+		Query:         req.Query,
+		HasAttachment: req.HasAttachment,
+		Limit:         int(req.Limit),
+		Offset:        int(req.Offset),
+	}
+
+	var violations []apperror.FieldViolation
+	var err error
+	if req.From != "" {
+		if res.From, err = time.Parse(time.RFC3339, req.From); err != nil {
+			violations = append(violations, apperror.FieldViolation{Field: "from", Description: "value must be an RFC3339 timestamp"})
+		}
+	}
+	if req.To != "" {
+		if res.To, err = time.Parse(time.RFC3339, req.To); err != nil {
+			violations = append(violations, apperror.FieldViolation{Field: "to", Description: "value must be an RFC3339 timestamp"})
+		}
+	}
+	if len(violations) > 0 {
+		return nil, apperror.Invalid("invalid date range", violations...)
+	}
+	return res, nil
+}
+
+func ConvertSearchHitsToPb(hits []SearchHit) []*pb.SearchHit {
+	var result []*pb.SearchHit
+	for _, h := range hits {
+		hit := &pb.SearchHit{
+			Chat:    ConvertChatToPbStream(h.Chat, pb.Action_Create),
+			Snippet: h.Snippet,
+			Score:   h.Score,
+		}
+		for _, hl := range h.Highlights {
+			hit.Highlights = append(hit.Highlights, &pb.Highlight{Start: int32(hl.Start), End: int32(hl.End)})
+		}
+		result = append(result, hit)
+	}
+	return result
+}
@@ -0,0 +1,51 @@
+package helper
+
+import (
+	"chat_api/entity"
+	"chat_api/pb"
+	"time"
+)
+
+// dto, field nil berarti tidak berubah
+type UpdateProfileReq struct {
+	Username           *string
+	DisplayName        *string
+	Bio                *string
+	AvatarAttachmentID *uint
+	Discoverable       *bool
+}
+
+func ParsingPbToUpdateProfile(req *pb.UpdateProfileRequest) *UpdateProfileReq {
+	res := &UpdateProfileReq{
+		Username:     req.Username,
+		DisplayName:  req.DisplayName,
+		Bio:          req.Bio,
+		Discoverable: req.Discoverable,
+	}
+	if req.AvatarAttachmentId != nil {
+		id := uint(*req.AvatarAttachmentId)
+		res.AvatarAttachmentID = &id
+	}
+	return res
+}
+
+func ConvertUserToPb(user *entity.User) *pb.UserProfile {
+	res := &pb.UserProfile{
+		Id:          uint64(user.ID),
+		Username:    user.Username,
+		DisplayName: user.DisplayName,
+		Bio:         user.Bio,
+		CreatedAt:   user.CreatedAt.Format(time.RFC3339),
+	}
+	if user.AvatarAttachmentID != nil {
+		res.AvatarAttachmentId = uint64(*user.AvatarAttachmentID)
+	}
+	return res
+}
+
+func ConvertUserBlockToPb(block *entity.UserBlock) *pb.BlockedUser {
+	return &pb.BlockedUser{
+		User:      ConvertUserToPb(&block.BlockedUser),
+		BlockedAt: block.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -38,11 +38,50 @@ var (
 		"/pb.ChatService/UpdateRoleUser": true,
 
 		"/pb.ChatService/GetListGroup": true,
+
+		"/pb.ChatService/AddReaction":            true,
+		"/pb.ChatService/RemoveReaction":         true,
+		"/pb.ChatService/ListThread":             true,
+		"/pb.ChatService/ListMentions":           true,
+		"/pb.ChatService/PinMessage":             true,
+		"/pb.ChatService/UnpinMessage":           true,
+		"/pb.ChatService/ListPinnedMessages":     true,
+		"/pb.ChatService/SearchMessages":         true,
+		"/pb.ChatService/SearchPublicGroups":     true,
+		"/pb.ChatService/GetGroupPreview":        true,
+		"/pb.ChatService/JoinPublicGroup":        true,
+		"/pb.ChatService/RequestToJoin":          true,
+		"/pb.ChatService/ListJoinRequests":       true,
+		"/pb.ChatService/ApproveJoinRequest":     true,
+		"/pb.ChatService/RejectJoinRequest":      true,
+		"/pb.ChatService/CreateInvite":           true,
+		"/pb.ChatService/ListInvites":            true,
+		"/pb.ChatService/RevokeInvite":           true,
+		"/pb.ChatService/JoinByInvite":           true,
+		"/pb.ChatService/ScheduleMessage":        true,
+		"/pb.ChatService/ListScheduledMessages":  true,
+		"/pb.ChatService/CancelScheduledMessage": true,
+		"/pb.ChatService/ForwardMessages":        true,
+		"/pb.ChatService/GetMessageRevisions":    true,
+		"/pb.ChatService/PurgeChat":              true,
+		"/pb.ChatService/SetTyping":              true,
+
+		"/pb.UserService/GetMe":            true,
+		"/pb.UserService/UpdateProfile":    true,
+		"/pb.UserService/GetUser":          true,
+		"/pb.UserService/GetUsers":         true,
+		"/pb.UserService/SearchUsers":      true,
+		"/pb.UserService/BlockUser":        true,
+		"/pb.UserService/UnblockUser":      true,
+		"/pb.UserService/ListBlockedUsers": true,
 	}
 
 	allowedStreamMethods = map[string]bool{
 		"/pb.ChatService/ChatStreaming":   true,
 		"/pb.ChatService/StatusStreaming": true,
+
+		"/pb.ChatService/UploadAttachment":   true,
+		"/pb.ChatService/DownloadAttachment": true,
 	}
 )
 
@@ -65,18 +104,11 @@ func StreamInterceptor() grpc.StreamServerInterceptor {
 			return status.Error(codes.Unauthenticated, "authorization header tidak ditemukan")
 		}
 
-		tokenString := strings.TrimPrefix(authHeaders[0], "Bearer ")
-		if tokenString == authHeaders[0] {
-			return status.Error(codes.Unauthenticated, "format token tidak valid (harus Bearer ...)")
-		}
-
-		claims, err := helper.ParseJWT(tokenString)
+		newCtx, err := Authenticate(ctx, authHeaders[0])
 		if err != nil {
-			return status.Error(codes.Unauthenticated, "invalid token ")
+			return err
 		}
 
-		newCtx := context.WithValue(ctx, UserContextKey, claims)
-
 		wrapped := &wrappedStream{ServerStream: ss, ctx: newCtx}
 
 		return handler(srv, wrapped)
@@ -114,3 +146,20 @@ func UnaryInterceptor() grpc.UnaryServerInterceptor {
 		return handler(newCtx, req)
 	}
 }
+
+// Authenticate validates a "Bearer <token>" value and returns ctx carrying the
+// JWT claims under UserContextKey. It is shared with transports that do not go
+// through the gRPC interceptors, such as the WebSocket/SSE bridge.
+func Authenticate(ctx context.Context, authHeader string) (context.Context, error) {
+	tokenString := strings.TrimPrefix(authHeader, "Bearer ")
+	if tokenString == authHeader {
+		return nil, status.Error(codes.Unauthenticated, "format token tidak valid (harus Bearer ...)")
+	}
+
+	claims, err := helper.ParseJWT(tokenString)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid token ")
+	}
+
+	return context.WithValue(ctx, UserContextKey, claims), nil
+}
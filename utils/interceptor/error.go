@@ -0,0 +1,127 @@
+package interceptor
+
+import (
+	"chat_api/utils/apperror"
+	"context"
+	"errors"
+	"log"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+var kindCodes = map[apperror.Kind]codes.Code{
+	apperror.KindNotFound:         codes.NotFound,
+	apperror.KindPermissionDenied: codes.PermissionDenied,
+	apperror.KindAlreadyExists:    codes.AlreadyExists,
+	apperror.KindInvalid:          codes.InvalidArgument,
+	apperror.KindConflict:         codes.FailedPrecondition,
+	apperror.KindUnauthenticated:  codes.Unauthenticated,
+	apperror.KindRateLimited:      codes.ResourceExhausted,
+}
+
+// validationError and multiError match the error types generated by
+// protoc-gen-validate for Validate and ValidateAll.
+type validationError interface {
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+type multiError interface {
+	AllErrors() []error
+}
+
+func ErrorUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, ToStatus(info.FullMethod, err).Err()
+		}
+		return resp, nil
+	}
+}
+
+func ErrorStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := handler(srv, ss); err != nil {
+			return ToStatus(info.FullMethod, err).Err()
+		}
+		return nil
+	}
+}
+
+// ToStatus maps a handler error to the status sent to clients. Status errors
+// pass through, domain errors and validation errors get their code and
+// details, anything else is logged and hidden behind codes.Internal.
+func ToStatus(method string, err error) *status.Status {
+	if st, ok := status.FromError(err); ok {
+		return st
+	}
+
+	if violations := validationViolations(err, ""); len(violations) > 0 {
+		err = apperror.Invalid("validation error", violations...)
+	}
+
+	var appErr *apperror.Error
+	if !errors.As(err, &appErr) || appErr.Kind == apperror.KindInternal {
+		log.Printf("%s: %v", method, err)
+		return status.New(codes.Internal, "internal server error")
+	}
+
+	st := status.New(kindCodes[appErr.Kind], appErr.Message)
+
+	var detail protoadapt.MessageV1
+	if appErr.Kind == apperror.KindInvalid && len(appErr.Violations) > 0 {
+		badRequest := &errdetails.BadRequest{}
+		for _, v := range appErr.Violations {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       v.Field,
+				Description: v.Description,
+			})
+		}
+		detail = badRequest
+	} else {
+		detail = &errdetails.ErrorInfo{
+			Reason: appErr.Kind.String(),
+			Domain: "chat_api",
+		}
+	}
+
+	if withDetails, err := st.WithDetails(detail); err == nil {
+		return withDetails
+	}
+	return st
+}
+
+// validationViolations flattens protoc-gen-validate errors into field
+// violations, following embedded message causes ("ListUserId[0].UserId").
+func validationViolations(err error, prefix string) []apperror.FieldViolation {
+	var multi multiError
+	if errors.As(err, &multi) {
+		var result []apperror.FieldViolation
+		for _, e := range multi.AllErrors() {
+			result = append(result, validationViolations(e, prefix)...)
+		}
+		return result
+	}
+
+	var ve validationError
+	if !errors.As(err, &ve) {
+		return nil
+	}
+
+	field := ve.Field()
+	if prefix != "" {
+		field = prefix + "." + field
+	}
+	if cause := ve.Cause(); cause != nil {
+		if nested := validationViolations(cause, field); len(nested) > 0 {
+			return nested
+		}
+	}
+	return []apperror.FieldViolation{{Field: field, Description: ve.Reason()}}
+}
@@ -0,0 +1,63 @@
+package interceptor
+
+import (
+	"chat_api/utils/apperror"
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// validatorAll and validator are implemented by every message generated by
+// protoc-gen-validate.
+type validatorAll interface {
+	ValidateAll() error
+}
+
+type validator interface {
+	Validate() error
+}
+
+type validatingStream struct {
+	grpc.ServerStream
+}
+
+func (s *validatingStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return ValidateMessage(m)
+}
+
+// ValidateMessage runs the PGV rules of msg, collecting every field violation
+// when ValidateAll is available. Messages without rules are accepted.
+func ValidateMessage(msg any) error {
+	var err error
+	switch v := msg.(type) {
+	case validatorAll:
+		err = v.ValidateAll()
+	case validator:
+		err = v.Validate()
+	}
+	if err == nil {
+		return nil
+	}
+
+	return apperror.Invalid("validation error", validationViolations(err, "")...)
+}
+
+func ValidationUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := ValidateMessage(req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// ValidationStreamInterceptor validates every message received on the stream,
+// including the request of server-streaming RPCs.
+func ValidationStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &validatingStream{ServerStream: ss})
+	}
+}
@@ -0,0 +1,176 @@
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+)
+
+const gpsIFDTag = 0x8825
+
+// byte size per TIFF field type
+var tiffTypeSizes = map[uint16]uint64{
+	1: 1, 2: 1, 3: 2, 4: 4, 5: 8, 6: 1, 7: 1, 8: 2, 9: 4, 10: 8, 11: 4, 12: 8,
+}
+
+var (
+	exifHeader = []byte("Exif\x00\x00")
+	pngMagic   = []byte("\x89PNG\r\n\x1a\n")
+)
+
+// StripLocation removes EXIF GPS data from a JPEG, PNG or WebP file in place
+// and reports whether anything was removed. The GPS IFD is zeroed instead of
+// cut out so no other offset in the file has to be rewritten.
+func StripLocation(data []byte) bool {
+	switch {
+	case bytes.HasPrefix(data, []byte{0xFF, 0xD8}):
+		return stripJPEG(data)
+	case bytes.HasPrefix(data, pngMagic):
+		return stripPNG(data)
+	case len(data) >= 12 && string(data[:4]) == "RIFF" && string(data[8:12]) == "WEBP":
+		return stripWebP(data)
+	}
+	return false
+}
+
+func stripJPEG(data []byte) bool {
+	changed := false
+	for i := 2; i+4 <= len(data); {
+		if data[i] != 0xFF {
+			break
+		}
+		marker := data[i+1]
+		switch {
+		case marker == 0xFF:
+			// fill byte
+			i++
+			continue
+		case marker == 0xDA || marker == 0xD9:
+			// start of scan, tidak ada metadata lagi setelah ini
+			return changed
+		case marker == 0x01 || (marker >= 0xD0 && marker <= 0xD7):
+			i += 2
+			continue
+		}
+
+		length := int(binary.BigEndian.Uint16(data[i+2:]))
+		if length < 2 || i+2+length > len(data) {
+			break
+		}
+		seg := data[i+4 : i+2+length]
+		if marker == 0xE1 && bytes.HasPrefix(seg, exifHeader) && stripTIFFGPS(seg[len(exifHeader):]) {
+			changed = true
+		}
+		i += 2 + length
+	}
+	return changed
+}
+
+func stripPNG(data []byte) bool {
+	changed := false
+	for i := len(pngMagic); i+12 <= len(data); {
+		length := int(binary.BigEndian.Uint32(data[i:]))
+		if length < 0 || i+12+length > len(data) {
+			break
+		}
+		typ := data[i+4 : i+8]
+		if string(typ) == "eXIf" && stripTIFFGPS(data[i+8:i+8+length]) {
+			// CRC dihitung ulang dari type + data
+			binary.BigEndian.PutUint32(data[i+8+length:], crc32.ChecksumIEEE(data[i+4:i+8+length]))
+			changed = true
+		}
+		if string(typ) == "IEND" {
+			break
+		}
+		i += 12 + length
+	}
+	return changed
+}
+
+func stripWebP(data []byte) bool {
+	changed := false
+	for i := 12; i+8 <= len(data); {
+		length := int(binary.LittleEndian.Uint32(data[i+4:]))
+		if length < 0 || i+8+length > len(data) {
+			break
+		}
+		if string(data[i:i+4]) == "EXIF" {
+			// beberapa encoder tetap menulis header "Exif\0\0"
+			tiff := bytes.TrimPrefix(data[i+8:i+8+length], exifHeader)
+			if stripTIFFGPS(tiff) {
+				changed = true
+			}
+		}
+		i += 8 + length + length%2
+	}
+	return changed
+}
+
+// stripTIFFGPS zeroes the GPS IFD referenced from IFD0, including the values
+// stored outside the entries.
+func stripTIFFGPS(tiff []byte) bool {
+	if len(tiff) < 8 {
+		return false
+	}
+
+	var bo binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		bo = binary.LittleEndian
+	case "MM":
+		bo = binary.BigEndian
+	default:
+		return false
+	}
+
+	gpsOffset, ok := findIFDTag(tiff, bo, uint64(bo.Uint32(tiff[4:])), gpsIFDTag)
+	if !ok {
+		return false
+	}
+	return clearIFD(tiff, bo, gpsOffset)
+}
+
+func findIFDTag(tiff []byte, bo binary.ByteOrder, offset uint64, tag uint16) (uint64, bool) {
+	if offset+2 > uint64(len(tiff)) {
+		return 0, false
+	}
+	count := uint64(bo.Uint16(tiff[offset:]))
+	for i := uint64(0); i < count; i++ {
+		entry := offset + 2 + i*12
+		if entry+12 > uint64(len(tiff)) {
+			return 0, false
+		}
+		if bo.Uint16(tiff[entry:]) == tag {
+			return uint64(bo.Uint32(tiff[entry+8:])), true
+		}
+	}
+	return 0, false
+}
+
+func clearIFD(tiff []byte, bo binary.ByteOrder, offset uint64) bool {
+	if offset+2 > uint64(len(tiff)) {
+		return false
+	}
+	count := uint64(bo.Uint16(tiff[offset:]))
+	end := offset + 2 + count*12
+	if count == 0 || end > uint64(len(tiff)) {
+		return false
+	}
+
+	for i := uint64(0); i < count; i++ {
+		entry := offset + 2 + i*12
+		size := tiffTypeSizes[bo.Uint16(tiff[entry+2:])] * uint64(bo.Uint32(tiff[entry+4:]))
+		if size <= 4 {
+			continue
+		}
+		// nilai lebih dari 4 byte disimpan di offset terpisah
+		valueOffset := uint64(bo.Uint32(tiff[entry+8:]))
+		if valueOffset+size <= uint64(len(tiff)) {
+			clear(tiff[valueOffset : valueOffset+size])
+		}
+	}
+
+	// count jadi 0 dan semua entry hilang
+	clear(tiff[offset:end])
+	return true
+}
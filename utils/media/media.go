@@ -0,0 +1,85 @@
+package media
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/jpeg"
+	"io"
+
+	// decoder pure Go yang didukung pipeline
+	_ "image/gif"
+	_ "image/png"
+
+	_ "golang.org/x/image/webp"
+
+	"github.com/buckket/go-blurhash"
+	"golang.org/x/image/draw"
+)
+
+// maxPixels guards against decompression bombs; a 10MB file can still
+// declare a huge canvas.
+const maxPixels = 50_000_000
+
+var ErrTooLarge = errors.New("image dimensions too large")
+
+var imageTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/gif":  true,
+	"image/webp": true,
+}
+
+// IsImage reports whether the content type is one the pipeline can decode.
+func IsImage(contentType string) bool {
+	return imageTypes[contentType]
+}
+
+// Decode checks the declared dimensions before decoding the full image.
+func Decode(data []byte) (image.Image, error) {
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Width*cfg.Height > maxPixels {
+		return nil, ErrTooLarge
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	return img, err
+}
+
+// Thumbnail scales img down so its longest edge is maxEdge, keeping the
+// aspect ratio. ok is false when the image is already small enough.
+func Thumbnail(img image.Image, maxEdge int) (thumb image.Image, ok bool) {
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w <= maxEdge && h <= maxEdge {
+		return nil, false
+	}
+
+	if w >= h {
+		h = max(1, h*maxEdge/w)
+		w = maxEdge
+	} else {
+		w = max(1, w*maxEdge/h)
+		h = maxEdge
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, b, draw.Src, nil)
+	return dst, true
+}
+
+// Blurhash encodes a 4x3 component placeholder. The image is shrunk first,
+// the hash only keeps low frequencies anyway.
+func Blurhash(img image.Image) (string, error) {
+	if small, ok := Thumbnail(img, 64); ok {
+		img = small
+	}
+	return blurhash.Encode(4, 3, img)
+}
+
+func EncodeJPEG(w io.Writer, img image.Image) error {
+	return jpeg.Encode(w, img, &jpeg.Options{Quality: 80})
+}
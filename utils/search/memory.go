@@ -0,0 +1,282 @@
+package search
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// BM25 parameters
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+
+	snippetRunes  = 160
+	snippetBefore = 40
+)
+
+type memoryDoc struct {
+	Document
+	length int
+}
+
+// memoryIndex is an inverted index (word -> document -> term frequency)
+// ranked with BM25.
+type memoryIndex struct {
+	mu          sync.RWMutex
+	docs        map[uint]*memoryDoc
+	postings    map[string]map[uint]int
+	totalLength int
+}
+
+func NewMemoryIndex() Index {
+	return &memoryIndex{
+		docs:     make(map[uint]*memoryDoc),
+		postings: make(map[string]map[uint]int),
+	}
+}
+
+func (m *memoryIndex) Index(doc Document) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.remove(doc.ID)
+
+	tokens := tokenize(doc.Text)
+	for _, t := range tokens {
+		posting, ok := m.postings[t.word]
+		if !ok {
+			posting = make(map[uint]int)
+			m.postings[t.word] = posting
+		}
+		posting[doc.ID]++
+	}
+	m.docs[doc.ID] = &memoryDoc{Document: doc, length: len(tokens)}
+	m.totalLength += len(tokens)
+	return nil
+}
+
+func (m *memoryIndex) Delete(id uint) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.remove(id)
+	return nil
+}
+
+func (m *memoryIndex) remove(id uint) {
+	doc, ok := m.docs[id]
+	if !ok {
+		return
+	}
+	for _, t := range tokenize(doc.Text) {
+		posting := m.postings[t.word]
+		delete(posting, id)
+		if len(posting) == 0 {
+			delete(m.postings, t.word)
+		}
+	}
+	m.totalLength -= doc.length
+	delete(m.docs, id)
+}
+
+func (m *memoryIndex) Search(q Query) (*Result, error) {
+	words := uniqueWords(tokenize(q.Text))
+	if len(words) == 0 {
+		return nil, ErrEmptyQuery
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	postings := make([]map[uint]int, len(words))
+	for i, w := range words {
+		postings[i] = m.postings[w]
+		if len(postings[i]) == 0 {
+			return &Result{}, nil
+		}
+	}
+
+	// iterasi dari posting terkecil, dokumen harus mengandung semua kata
+	smallest := 0
+	for i := range postings {
+		if len(postings[i]) < len(postings[smallest]) {
+			smallest = i
+		}
+	}
+
+	groups := make(map[uint]bool, len(q.GroupIDs))
+	for _, id := range q.GroupIDs {
+		groups[id] = true
+	}
+
+	n := float64(len(m.docs))
+	avgLength := float64(m.totalLength) / n
+
+	var matches []*memoryDoc
+	scores := make(map[uint]float64)
+	for id := range postings[smallest] {
+		doc := m.docs[id]
+		if !m.matchFilter(doc, q, groups) {
+			continue
+		}
+
+		score := 0.0
+		matched := true
+		for _, posting := range postings {
+			tf, ok := posting[id]
+			if !ok {
+				matched = false
+				break
+			}
+			df := float64(len(posting))
+			idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+			norm := bm25K1 * (1 - bm25B + bm25B*float64(doc.length)/avgLength)
+			score += idf * float64(tf) * (bm25K1 + 1) / (float64(tf) + norm)
+		}
+		if !matched {
+			continue
+		}
+		scores[id] = score
+		matches = append(matches, doc)
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		if scores[a.ID] != scores[b.ID] {
+			return scores[a.ID] > scores[b.ID]
+		}
+		if !a.CreatedAt.Equal(b.CreatedAt) {
+			return a.CreatedAt.After(b.CreatedAt)
+		}
+		return a.ID > b.ID
+	})
+
+	result := &Result{Total: len(matches)}
+	if q.Offset >= len(matches) {
+		return result, nil
+	}
+	matches = matches[q.Offset:]
+	if q.Limit > 0 && len(matches) > q.Limit {
+		matches = matches[:q.Limit]
+	}
+
+	wordSet := make(map[string]bool, len(words))
+	for _, w := range words {
+		wordSet[w] = true
+	}
+	for _, doc := range matches {
+		snippet, highlights := buildSnippet(doc.Text, wordSet)
+		result.Hits = append(result.Hits, Hit{
+			ID:         doc.ID,
+			Score:      scores[doc.ID],
+			Snippet:    snippet,
+			Highlights: highlights,
+		})
+	}
+	return result, nil
+}
+
+func (m *memoryIndex) matchFilter(doc *memoryDoc, q Query, groups map[uint]bool) bool {
+	if len(groups) > 0 && !groups[doc.GroupID] {
+		return false
+	}
+	if q.AuthorID != 0 && doc.AuthorID != q.AuthorID {
+		return false
+	}
+	if !q.From.IsZero() && doc.CreatedAt.Before(q.From) {
+		return false
+	}
+	if !q.To.IsZero() && !doc.CreatedAt.Before(q.To) {
+		return false
+	}
+	if q.HasAttachment != nil && doc.HasAttachment != *q.HasAttachment {
+		return false
+	}
+	if !q.Now.IsZero() && doc.ExpiresAt != nil && !doc.ExpiresAt.After(q.Now) {
+		return false
+	}
+	return true
+}
+
+type token struct {
+	word       string
+	start, end int // rune offset di teks asli
+}
+
+// tokenize splits text into lower-cased runs of letters and digits
+func tokenize(text string) []token {
+	var tokens []token
+	var b strings.Builder
+	start := -1
+	pos := 0
+	for _, r := range text {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if start < 0 {
+				start = pos
+			}
+			b.WriteRune(unicode.ToLower(r))
+		} else if start >= 0 {
+			tokens = append(tokens, token{word: b.String(), start: start, end: pos})
+			b.Reset()
+			start = -1
+		}
+		pos++
+	}
+	if start >= 0 {
+		tokens = append(tokens, token{word: b.String(), start: start, end: pos})
+	}
+	return tokens
+}
+
+func uniqueWords(tokens []token) []string {
+	seen := make(map[string]bool)
+	var words []string
+	for _, t := range tokens {
+		if !seen[t.word] {
+			seen[t.word] = true
+			words = append(words, t.word)
+		}
+	}
+	return words
+}
+
+// buildSnippet cuts snippetRunes runes of text around the first matched word
+// and returns the offsets of every matched word inside the snippet.
+func buildSnippet(text string, words map[string]bool) (string, []Highlight) {
+	runes := []rune(text)
+	tokens := tokenize(text)
+
+	start := 0
+	for _, t := range tokens {
+		if words[t.word] {
+			start = max(t.start-snippetBefore, 0)
+			break
+		}
+	}
+	// jangan memotong di tengah kata
+	for start > 0 && !unicode.IsSpace(runes[start-1]) && start < len(runes) && !unicode.IsSpace(runes[start]) {
+		start--
+	}
+	end := min(start+snippetRunes, len(runes))
+
+	prefix := ""
+	if start > 0 {
+		prefix = "…"
+	}
+	suffix := ""
+	if end < len(runes) {
+		suffix = "…"
+	}
+	offset := len([]rune(prefix)) - start
+
+	var highlights []Highlight
+	for _, t := range tokens {
+		if words[t.word] && t.start >= start && t.end <= end {
+			highlights = append(highlights, Highlight{Start: t.start + offset, End: t.end + offset})
+		}
+	}
+	return prefix + string(runes[start:end]) + suffix, highlights
+}
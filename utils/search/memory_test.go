@@ -0,0 +1,157 @@
+package search
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func hitIDs(res *Result) []uint {
+	var ids []uint
+	for _, h := range res.Hits {
+		ids = append(ids, h.ID)
+	}
+	return ids
+}
+
+func TestMemoryIndexSearch(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	expired := base.Add(time.Hour)
+	yes, no := true, false
+
+	idx := NewMemoryIndex()
+	docs := []Document{
+		{ID: 1, GroupID: 1, AuthorID: 10, Text: "deploy besok pagi", CreatedAt: base},
+		{ID: 2, GroupID: 1, AuthorID: 11, Text: "Deploy deploy DEPLOY", CreatedAt: base.Add(time.Minute)},
+		{ID: 3, GroupID: 2, AuthorID: 10, Text: "deploy gagal, cek log", CreatedAt: base.Add(2 * time.Minute), HasAttachment: true},
+		{ID: 4, GroupID: 2, AuthorID: 11, Text: "makan siang", CreatedAt: base.Add(3 * time.Minute)},
+		{ID: 5, GroupID: 1, AuthorID: 10, Text: "deploy rahasia", CreatedAt: base.Add(4 * time.Minute), ExpiresAt: &expired},
+	}
+	for _, d := range docs {
+		if err := idx.Index(d); err != nil {
+			t.Fatalf("Index(%d): %v", d.ID, err)
+		}
+	}
+
+	tests := []struct {
+		name      string
+		query     Query
+		want      []uint
+		wantTotal int
+	}{
+		{name: "ranked by term frequency", query: Query{Text: "deploy", GroupIDs: []uint{1}}, want: []uint{2, 5, 1}, wantTotal: 3},
+		{name: "every word must match", query: Query{Text: "deploy log"}, want: []uint{3}, wantTotal: 1},
+		{name: "unknown word", query: Query{Text: "deploy kopi"}, wantTotal: 0},
+		{name: "group filter", query: Query{Text: "deploy", GroupIDs: []uint{2}}, want: []uint{3}, wantTotal: 1},
+		{name: "author filter", query: Query{Text: "deploy", AuthorID: 10, GroupIDs: []uint{1}}, want: []uint{5, 1}, wantTotal: 2},
+		{name: "from inclusive, to exclusive", query: Query{Text: "deploy", From: base.Add(time.Minute), To: base.Add(4 * time.Minute)}, want: []uint{2, 3}, wantTotal: 2},
+		{name: "has attachment", query: Query{Text: "deploy", HasAttachment: &yes}, want: []uint{3}, wantTotal: 1},
+		{name: "without attachment", query: Query{Text: "deploy", HasAttachment: &no, GroupIDs: []uint{2}}, wantTotal: 0},
+		{name: "expired excluded from total", query: Query{Text: "deploy", GroupIDs: []uint{1}, Now: expired}, want: []uint{2, 1}, wantTotal: 2},
+		{name: "not yet expired", query: Query{Text: "rahasia", Now: base}, want: []uint{5}, wantTotal: 1},
+		{name: "limit keeps total", query: Query{Text: "deploy", GroupIDs: []uint{1}, Limit: 1}, want: []uint{2}, wantTotal: 3},
+		{name: "offset", query: Query{Text: "deploy", GroupIDs: []uint{1}, Offset: 1, Limit: 1}, want: []uint{5}, wantTotal: 3},
+		{name: "offset past the end", query: Query{Text: "deploy", Offset: 10}, wantTotal: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := idx.Search(tt.query)
+			if err != nil {
+				t.Fatalf("Search: %v", err)
+			}
+			if got := hitIDs(res); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("hits = %v, want %v", got, tt.want)
+			}
+			if res.Total != tt.wantTotal {
+				t.Errorf("total = %d, want %d", res.Total, tt.wantTotal)
+			}
+		})
+	}
+}
+
+func TestMemoryIndexTieBreak(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	idx := NewMemoryIndex()
+	idx.Index(Document{ID: 1, Text: "halo", CreatedAt: base})
+	idx.Index(Document{ID: 2, Text: "halo", CreatedAt: base.Add(time.Second)})
+	idx.Index(Document{ID: 3, Text: "halo", CreatedAt: base})
+
+	res, err := idx.Search(Query{Text: "halo"})
+	if err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+	// skor sama: terbaru dulu, lalu id terbesar
+	if got, want := hitIDs(res), []uint{2, 3, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("hits = %v, want %v", got, want)
+	}
+}
+
+func TestMemoryIndexUpdateAndDelete(t *testing.T) {
+	idx := NewMemoryIndex()
+	idx.Index(Document{ID: 1, Text: "pesan lama"})
+	idx.Index(Document{ID: 1, Text: "pesan baru"})
+
+	if res, _ := idx.Search(Query{Text: "lama"}); res.Total != 0 {
+		t.Errorf("old text still indexed, total = %d", res.Total)
+	}
+	if res, _ := idx.Search(Query{Text: "baru"}); !reflect.DeepEqual(hitIDs(res), []uint{1}) {
+		t.Errorf("new text hits = %v, want [1]", hitIDs(res))
+	}
+
+	if err := idx.Delete(1); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if res, _ := idx.Search(Query{Text: "pesan"}); res.Total != 0 {
+		t.Errorf("deleted document still found, total = %d", res.Total)
+	}
+	// menghapus id yang tidak ada bukan error
+	if err := idx.Delete(42); err != nil {
+		t.Errorf("Delete(missing): %v", err)
+	}
+}
+
+func TestMemoryIndexEmptyQuery(t *testing.T) {
+	idx := NewMemoryIndex()
+	idx.Index(Document{ID: 1, Text: "halo"})
+
+	for _, text := range []string{"", "   ", "?!..."} {
+		if _, err := idx.Search(Query{Text: text}); !errors.Is(err, ErrEmptyQuery) {
+			t.Errorf("Search(%q) err = %v, want ErrEmptyQuery", text, err)
+		}
+	}
+}
+
+func TestMemoryIndexSnippet(t *testing.T) {
+	idx := NewMemoryIndex()
+	idx.Index(Document{ID: 1, Text: "Server DOWN lagi, server harus restart"})
+	long := strings.Repeat("kata ", 40) + "target " + strings.Repeat("lain ", 40)
+	idx.Index(Document{ID: 2, Text: long})
+
+	res, err := idx.Search(Query{Text: "server"})
+	if err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+	hit := res.Hits[0]
+	if hit.Snippet != "Server DOWN lagi, server harus restart" {
+		t.Errorf("snippet = %q", hit.Snippet)
+	}
+	if want := []Highlight{{0, 6}, {18, 24}}; !reflect.DeepEqual(hit.Highlights, want) {
+		t.Errorf("highlights = %v, want %v", hit.Highlights, want)
+	}
+
+	res, err = idx.Search(Query{Text: "target"})
+	if err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+	hit = res.Hits[0]
+	runes := []rune(hit.Snippet)
+	if runes[0] != '…' || runes[len(runes)-1] != '…' {
+		t.Errorf("long snippet not trimmed on both sides: %q", hit.Snippet)
+	}
+	if len(hit.Highlights) != 1 || string(runes[hit.Highlights[0].Start:hit.Highlights[0].End]) != "target" {
+		t.Errorf("highlights = %v in %q", hit.Highlights, hit.Snippet)
+	}
+}
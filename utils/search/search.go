@@ -0,0 +1,76 @@
+package search
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+)
+
+var ErrEmptyQuery = errors.New("query has no searchable words")
+
+// Document is the searchable part of a chat message
+type Document struct {
+	ID            uint
+	GroupID       uint
+	AuthorID      uint // user id, 0 kalau pengirim sudah keluar
+	Text          string
+	HasAttachment bool
+	CreatedAt     time.Time
+	ExpiresAt     *time.Time // disappearing message, nil kalau tidak kedaluwarsa
+}
+
+// Query matches documents containing every word of Text. Zero values of the
+// other fields mean "no filter".
+type Query struct {
+	Text          string
+	GroupIDs      []uint
+	AuthorID      uint
+	From, To      time.Time
+	HasAttachment *bool
+	// dokumen dengan ExpiresAt <= Now dilewati sebelum dihitung ke Total
+	Now           time.Time
+	Limit, Offset int
+}
+
+// Highlight is a matched word in Hit.Snippet, as rune offsets [Start, End)
+type Highlight struct {
+	Start, End int
+}
+
+type Hit struct {
+	ID         uint
+	Score      float64
+	Snippet    string
+	Highlights []Highlight
+}
+
+type Result struct {
+	Hits  []Hit
+	Total int
+}
+
+// Index keeps chat messages searchable. Backends other than the embedded
+// memory index (Elasticsearch, Meilisearch, ...) only need to implement this
+// interface.
+type Index interface {
+	Index(doc Document) error
+	Delete(id uint) error
+	Search(q Query) (*Result, error)
+}
+
+// New builds the backend selected by SEARCH_DRIVER. Only "memory" (default)
+// exists for now; it is rebuilt from the database on startup.
+func New() (Index, error) {
+	driver := os.Getenv("SEARCH_DRIVER")
+	if driver == "" {
+		driver = "memory"
+	}
+
+	switch driver {
+	case "memory":
+		return NewMemoryIndex(), nil
+	default:
+		return nil, fmt.Errorf("unsupported SEARCH_DRIVER %q (memory)", driver)
+	}
+}
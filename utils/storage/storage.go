@@ -0,0 +1,40 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+var ErrNotFound = errors.New("blob not found")
+
+// Storage stores attachment blobs under opaque keys generated by the caller.
+// Backends other than the local filesystem (S3, GCS, ...) only need to
+// implement this interface.
+type Storage interface {
+	Put(ctx context.Context, key string, r io.Reader) (int64, error)
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// New builds the backend selected by STORAGE_DRIVER. Only "local" (default)
+// exists for now, storing files under STORAGE_DIR.
+func New() (Storage, error) {
+	driver := os.Getenv("STORAGE_DRIVER")
+	if driver == "" {
+		driver = "local"
+	}
+
+	switch driver {
+	case "local":
+		dir := os.Getenv("STORAGE_DIR")
+		if dir == "" {
+			dir = "uploads"
+		}
+		return NewLocalStorage(dir)
+	default:
+		return nil, fmt.Errorf("unsupported STORAGE_DRIVER %q (local)", driver)
+	}
+}
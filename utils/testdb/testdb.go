@@ -0,0 +1,122 @@
+// Package testdb opens a migrated database for repository and usecase tests.
+package testdb
+
+import (
+	"chat_api/cmd/database"
+	"chat_api/cmd/migrations/migrate"
+	"chat_api/cmd/migrations/versions"
+	"chat_api/entity"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// Open returns a database with every migration applied, configured like
+// cmd/database. By default it is a fresh sqlite file in t.TempDir().
+//
+// TEST_DB_DRIVER=mysql or postgres runs against the server configured by the
+// usual DB_* variables instead. Every table in that database is dropped
+// first, so point it at a throwaway database and run with go test -p 1.
+func Open(t testing.TB) *gorm.DB {
+	t.Helper()
+
+	driver := os.Getenv("TEST_DB_DRIVER")
+	if driver == "" {
+		driver = "sqlite"
+	}
+
+	var dialector gorm.Dialector
+	if driver == "sqlite" {
+		dsn := fmt.Sprintf("file:%s?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)", filepath.Join(t.TempDir(), "test.db"))
+		dialector = sqlite.Open(dsn)
+	} else {
+		var err error
+		if dialector, err = database.OpenDialector(driver); err != nil {
+			t.Fatalf("TEST_DB_DRIVER: %v", err)
+		}
+	}
+
+	db, err := gorm.Open(dialector, &gorm.Config{
+		TranslateError: true,
+		Logger:         logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatalf("open %s db: %v", driver, err)
+	}
+	t.Cleanup(func() {
+		if sqlDB, err := db.DB(); err == nil {
+			sqlDB.Close()
+		}
+	})
+
+	if driver != "sqlite" {
+		reset(t, db)
+	}
+	if err := migrate.New(db, versions.All()).Up(); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	return db
+}
+
+// reset drops every table, including schema_migrations
+func reset(t testing.TB, db *gorm.DB) {
+	t.Helper()
+	tables, err := db.Migrator().GetTables()
+	if err != nil {
+		t.Fatalf("list tables: %v", err)
+	}
+	for _, table := range tables {
+		if err := db.Migrator().DropTable(table); err != nil {
+			t.Fatalf("drop %s: %v", table, err)
+		}
+	}
+}
+
+// User creates a user named username
+func User(t testing.TB, db *gorm.DB, username string) entity.User {
+	t.Helper()
+	user := entity.User{
+		Username:     username,
+		Email:        username + "@example.com",
+		Password:     "x",
+		Discoverable: true,
+	}
+	mustCreate(t, db, &user)
+	return user
+}
+
+// Group creates a group with the given visibility
+func Group(t testing.TB, db *gorm.DB, name, visibility string) entity.ChatGroup {
+	t.Helper()
+	group := entity.ChatGroup{Name: name, Visibility: visibility}
+	mustCreate(t, db, &group)
+	return group
+}
+
+// Member adds the user to the group
+func Member(t testing.TB, db *gorm.DB, groupId, userId uint, role string) entity.GroupMember {
+	t.Helper()
+	member := entity.GroupMember{GroupID: groupId, UserID: userId, Role: role}
+	mustCreate(t, db, &member)
+	return member
+}
+
+// Chat creates a chat sent by the member
+func Chat(t testing.TB, db *gorm.DB, groupId, memberId uint, message string) entity.Chat {
+	t.Helper()
+	chat := entity.Chat{GroupID: groupId, GroupMemberID: &memberId, Message: message}
+	mustCreate(t, db, &chat)
+	return chat
+}
+
+func mustCreate(t testing.TB, db *gorm.DB, value any) {
+	t.Helper()
+	if err := db.Create(value).Error; err != nil {
+		t.Fatalf("create %T: %v", value, err)
+	}
+}